@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/datasource/archive"
+	"github.com/ppaanngggg/option-bot/pkg/datasource/importer"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+)
+
+// importer converts vendor csv dumps of historical option quotes into the chain archive, e.g.
+//
+//	go run ./cmd/importer -mapping orats.json UnderlyingOptionsEODQuotes_2024-01-02.csv
+func main() {
+	mappingPath := flag.String("mapping", "", "json column mapping, default is CBOE DataShop")
+	skipInvalid := flag.Bool("skip-invalid", false, "skip invalid rows instead of failing")
+	flag.Parse()
+
+	ctx := context.Background()
+	logger := util.DefaultLogger.With(slog.F("cmd", "importer"))
+
+	mapping := importer.DefaultMapping()
+	if *mappingPath != "" {
+		var err error
+		if mapping, err = importer.LoadMapping(*mappingPath); err != nil {
+			logger.Fatal(ctx, "failed to load mapping", slog.Error(err))
+		}
+	}
+	if *skipInvalid {
+		mapping.SkipInvalid = true
+	}
+	imp := importer.NewImporter(mapping)
+	arch := archive.NewArchive(util.Conf.Archive.Dir)
+
+	for _, path := range flag.Args() {
+		f, err := os.Open(path)
+		if err != nil {
+			logger.Fatal(ctx, "failed to open file", slog.F("path", path), slog.Error(err))
+		}
+		snapshots, err := imp.Import(ctx, f)
+		f.Close()
+		if err != nil {
+			logger.Fatal(ctx, "failed to import file", slog.F("path", path), slog.Error(err))
+		}
+		chains := 0
+		for _, snapshot := range snapshots {
+			if err := arch.Append(snapshot.QuoteDate, snapshot.Chains); err != nil {
+				logger.Fatal(ctx, "failed to archive chains", slog.Error(err))
+			}
+			chains += len(snapshot.Chains)
+		}
+		logger.Info(
+			ctx, "file imported", slog.F("path", path),
+			slog.F("snapshots", len(snapshots)), slog.F("chains", chains),
+		)
+	}
+}
@@ -1,16 +1,23 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
 	"github.com/ppaanngggg/option-bot/pkg/account"
+	// register the brokers
+	_ "github.com/ppaanngggg/option-bot/pkg/account/alpaca"
+	_ "github.com/ppaanngggg/option-bot/pkg/account/schwab"
+	_ "github.com/ppaanngggg/option-bot/pkg/account/tradier"
 	"github.com/ppaanngggg/option-bot/pkg/bot"
 	"github.com/ppaanngggg/option-bot/pkg/datasource"
+	"github.com/ppaanngggg/option-bot/pkg/risk"
 	"github.com/ppaanngggg/option-bot/pkg/util"
 	"github.com/ppaanngggg/option-bot/proto/gen/account/v1/accountv1connect"
 	"github.com/ppaanngggg/option-bot/proto/gen/bot/v1/botv1connect"
 	"github.com/ppaanngggg/option-bot/proto/gen/datasource/v1/datasourcev1connect"
+	"github.com/ppaanngggg/option-bot/proto/gen/risk/v1/riskv1connect"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 )
@@ -29,6 +36,12 @@ func main() {
 		path, handler := datasourcev1connect.NewDataSourceServiceHandler(datasource.Service)
 		mux.Handle(path, handler)
 	}
+	{
+		path, handler := riskv1connect.NewRiskServiceHandler(risk.Service)
+		mux.Handle(path, handler)
+	}
+	go risk.Snapshotter.Run(context.Background())
+	go bot.Scheduler.Run(context.Background())
 	http.ListenAndServe(
 		fmt.Sprintf("%s:%d", util.Conf.Server.Host, util.Conf.Server.Port),
 		h2c.NewHandler(mux, &http2.Server{}),
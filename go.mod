@@ -9,6 +9,8 @@ require (
 	github.com/go-resty/resty/v2 v2.11.0
 	github.com/stretchr/testify v1.8.4
 	golang.org/x/net v0.21.0
+	golang.org/x/sync v0.6.0
+	golang.org/x/time v0.5.0
 	golang.org/x/xerrors v0.0.0-20220907171357-04be3eba64a2
 	google.golang.org/protobuf v1.32.0
 )
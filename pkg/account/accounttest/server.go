@@ -0,0 +1,56 @@
+// Package accounttest serves recorded responses of broker apis to test the broker clients
+package accounttest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Server is a fake broker api, it's closed once the test finishes
+type Server struct {
+	*httptest.Server
+	Mux *http.ServeMux
+	// authorized reports whether the request carries the credentials of the test
+	authorized func(r *http.Request) bool
+}
+
+func NewServer(t testing.TB, authorized func(r *http.Request) bool) *Server {
+	mux := http.NewServeMux()
+	server := &Server{Server: httptest.NewServer(mux), Mux: mux, authorized: authorized}
+	t.Cleanup(server.Close)
+	return server
+}
+
+// Respond writes the recorded json body to authorized requests, 401 otherwise
+func (s *Server) Respond(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}
+}
+
+// Handle responds the recorded json body to the requests of the pattern
+func (s *Server) Handle(pattern string, body string) {
+	s.Mux.HandleFunc(pattern, s.Respond(body))
+}
+
+// Record sends the json bodies of the requests of the pattern to the returned channel, then handles
+// the requests by handler, the channel buffers one body
+func (s *Server) Record(pattern string, handler http.HandlerFunc) <-chan map[string]any {
+	bodies := make(chan map[string]any, 1)
+	s.Mux.HandleFunc(
+		pattern, func(w http.ResponseWriter, r *http.Request) {
+			body := make(map[string]any)
+			json.NewDecoder(r.Body).Decode(&body)
+			bodies <- body
+			handler(w, r)
+		},
+	)
+	return bodies
+}
@@ -0,0 +1,469 @@
+package alpaca
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/go-resty/resty/v2"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+)
+
+func NewAlpaca(isLive bool, apiKey string, apiSecret string) *Alpaca {
+	alpaca := &Alpaca{
+		isLive:  isLive,
+		trading: resty.New(),
+		data:    resty.New(),
+		logger:  util.DefaultLogger.With(slog.F("broker", "alpaca")),
+	}
+	if alpaca.isLive {
+		alpaca.trading.SetBaseURL("https://api.alpaca.markets")
+	} else {
+		alpaca.trading.SetBaseURL("https://paper-api.alpaca.markets")
+	}
+	alpaca.data.SetBaseURL("https://data.alpaca.markets")
+	for _, client := range []*resty.Client{alpaca.trading, alpaca.data} {
+		client.SetHeaders(
+			map[string]string{
+				"APCA-API-KEY-ID":     apiKey,
+				"APCA-API-SECRET-KEY": apiSecret,
+				"Accept":              "application/json",
+			},
+		)
+		account.SetRetry(client, alpaca.logger)
+	}
+	return alpaca
+}
+
+var _ account.Market = (*Alpaca)(nil)
+
+type Alpaca struct {
+	isLive bool
+	// the trading api for accounts, orders, contracts and the calendar
+	trading *resty.Client
+	// the market data api for quotes and snapshots
+	data   *resty.Client
+	logger slog.Logger
+
+	// the expirations of every underlying of the day, alpaca only lists contracts
+	mu          sync.Mutex
+	expirations map[string]dailyExpirations
+}
+
+type dailyExpirations struct {
+	date string // YYYY-MM-DD
+	list []string
+}
+
+// decimal is a number alpaca encodes as a string, null as 0
+type decimal float64
+
+func (d *decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(data, `"`)
+	if len(data) == 0 || string(data) == "null" {
+		*d = 0
+		return nil
+	}
+	v, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		return err
+	}
+	*d = decimal(v)
+	return nil
+}
+
+// pageLimit is the max page size of the contracts and snapshots apis
+const pageLimit = "1000"
+
+// parseTime parses an RFC3339 timestamp to unix milli, 0 if it's empty
+func parseTime(op string, value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	at, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0, account.MalformedError(op, err)
+	}
+	return at.UnixMilli(), nil
+}
+
+// Search refer to https://docs.alpaca.markets/reference/get-v2-assets-symbol_or_asset_id,
+// alpaca has no lookup, so only the exact symbol is found
+func (a *Alpaca) Search(ctx context.Context, query string) ([]*datasourcev1.Symbol, error) {
+	body := &struct {
+		Symbol   string `json:"symbol"`
+		Name     string `json:"name"`
+		Class    string `json:"class"` // us_equity, us_option or crypto
+		Tradable bool   `json:"tradable"`
+	}{}
+	resp, err := a.trading.R().
+		SetContext(ctx).
+		SetResult(body).
+		Get("/v2/assets/" + strings.ToUpper(query))
+	if err := account.CheckResponse("search symbols", resp, err); err != nil {
+		if account.KindOf(err) == account.ErrorKindNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	symbol := &datasourcev1.Symbol{Symbol: body.Symbol, Description: body.Name}
+	switch body.Class {
+	case "us_equity":
+		symbol.Type = datasourcev1.SymbolType_SYMBOL_TYPE_STOCK
+	case "us_option":
+		symbol.Type = datasourcev1.SymbolType_SYMBOL_TYPE_OPTION
+	}
+	return []*datasourcev1.Symbol{symbol}, nil
+}
+
+// GetQuotes refer to https://docs.alpaca.markets/reference/stocksnapshots-1
+func (a *Alpaca) GetQuotes(ctx context.Context, symbols []string) ([]*datasourcev1.Quote, error) {
+	type bar struct {
+		Open   decimal `json:"o"`
+		High   decimal `json:"h"`
+		Low    decimal `json:"l"`
+		Close  decimal `json:"c"`
+		Volume int64   `json:"v"`
+	}
+	type snapshot struct {
+		LatestTrade struct {
+			Price decimal `json:"p"`
+			Time  string  `json:"t"`
+		} `json:"latestTrade"`
+		LatestQuote struct {
+			Bid decimal `json:"bp"`
+			Ask decimal `json:"ap"`
+		} `json:"latestQuote"`
+		DailyBar     bar `json:"dailyBar"`
+		PrevDailyBar bar `json:"prevDailyBar"`
+	}
+	body := make(map[string]*snapshot)
+	resp, err := a.data.R().
+		SetContext(ctx).
+		SetQueryParam("symbols", strings.Join(symbols, ",")).
+		SetResult(&body).
+		Get("/v2/stocks/snapshots")
+	if err := account.CheckResponse("get quotes", resp, err); err != nil {
+		return nil, err
+	}
+	quotes := make([]*datasourcev1.Quote, 0, len(symbols))
+	for _, symbol := range symbols {
+		s, ok := body[symbol]
+		if !ok || s == nil {
+			continue
+		}
+		quoteAt, err := parseTime("get quotes", s.LatestTrade.Time)
+		if err != nil {
+			return nil, err
+		}
+		quote := &datasourcev1.Quote{
+			Symbol:    symbol,
+			Last:      float64(s.LatestTrade.Price),
+			Bid:       float64(s.LatestQuote.Bid),
+			Ask:       float64(s.LatestQuote.Ask),
+			Open:      float64(s.DailyBar.Open),
+			High:      float64(s.DailyBar.High),
+			Low:       float64(s.DailyBar.Low),
+			PrevClose: float64(s.PrevDailyBar.Close),
+			Volume:    s.DailyBar.Volume,
+			QuoteAt:   quoteAt,
+		}
+		if quote.PrevClose > 0 {
+			quote.ChangePercentage = (quote.Last - quote.PrevClose) / quote.PrevClose * 100
+		}
+		quotes = append(quotes, quote)
+	}
+	return quotes, nil
+}
+
+// GetTodayTradePeriod refer to https://docs.alpaca.markets/reference/getcalendar-1
+func (a *Alpaca) GetTodayTradePeriod(ctx context.Context) (*datasourcev1.TradePeriod, error) {
+	today := time.Now().In(util.TZNewYork).Format("2006-01-02")
+	periods, err := a.GetTradeCalendar(ctx, today, today)
+	if err != nil {
+		return nil, err
+	}
+	if len(periods) == 0 {
+		return nil, xerrors.Errorf("today's trade period not found, today: %s", today)
+	}
+	return periods[0], nil
+}
+
+// GetTradeCalendar refer to https://docs.alpaca.markets/reference/getcalendar-1,
+// alpaca only returns the open days, the other days are closed
+func (a *Alpaca) GetTradeCalendar(
+	ctx context.Context, from string, to string,
+) ([]*datasourcev1.TradePeriod, error) {
+	start, err := time.ParseInLocation("2006-01-02", from, util.TZNewYork)
+	if err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	end, err := time.ParseInLocation("2006-01-02", to, util.TZNewYork)
+	if err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	var body []struct {
+		Date         string `json:"date"`          // YYYY-MM-DD
+		Open         string `json:"open"`          // 09:30
+		Close        string `json:"close"`         // 16:00
+		SessionOpen  string `json:"session_open"`  // 0400
+		SessionClose string `json:"session_close"` // 2000
+	}
+	resp, err := a.trading.R().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{"start": from, "end": to}).
+		SetResult(&body).
+		Get("/v2/calendar")
+	if err := account.CheckResponse("get trade calendar", resp, err); err != nil {
+		return nil, err
+	}
+	open := make(map[string]*datasourcev1.TradePeriod, len(body))
+	for _, day := range body {
+		parse := func(hhmm string) (int64, error) {
+			if hhmm == "" {
+				return 0, nil
+			}
+			if !strings.Contains(hhmm, ":") && len(hhmm) == 4 {
+				hhmm = hhmm[:2] + ":" + hhmm[2:]
+			}
+			at, err := time.ParseInLocation("2006-01-02 15:04", day.Date+" "+hhmm, util.TZNewYork)
+			if err != nil {
+				return 0, account.MalformedError("get trade calendar", err)
+			}
+			return at.UnixMilli(), nil
+		}
+		period := &datasourcev1.TradePeriod{Date: day.Date, IsOpen: true, IsEarlyClose: day.Close < "16:00"}
+		if period.OpenAt, err = parse(day.Open); err != nil {
+			return nil, err
+		}
+		if period.CloseAt, err = parse(day.Close); err != nil {
+			return nil, err
+		}
+		if period.PremarketOpenAt, err = parse(day.SessionOpen); err != nil {
+			return nil, err
+		}
+		if period.PostmarketCloseAt, err = parse(day.SessionClose); err != nil {
+			return nil, err
+		}
+		open[day.Date] = period
+	}
+	var periods []*datasourcev1.TradePeriod
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		if period, ok := open[date]; ok {
+			periods = append(periods, period)
+		} else {
+			periods = append(periods, &datasourcev1.TradePeriod{Date: date})
+		}
+	}
+	return periods, nil
+}
+
+// parseOptionSymbol splits an OCC option symbol, e.g. SPY240119P00460000 to SPY, call false and 460
+func parseOptionSymbol(symbol string) (root string, isCall bool, strike float64, err error) {
+	const suffix = 15
+	if len(symbol) <= suffix {
+		return "", false, 0, xerrors.Errorf("invalid option symbol: %s", symbol)
+	}
+	root, rest := symbol[:len(symbol)-suffix], symbol[len(symbol)-suffix:]
+	millis, err := strconv.ParseInt(rest[7:], 10, 64)
+	if err != nil || (rest[6] != 'C' && rest[6] != 'P') {
+		return "", false, 0, xerrors.Errorf("invalid option symbol: %s", symbol)
+	}
+	return root, rest[6] == 'C', float64(millis) / 1000, nil
+}
+
+// GetOptionChains refer to https://docs.alpaca.markets/reference/optionchain,
+// the snapshots are grouped to chains by the roots of their symbols
+func (a *Alpaca) GetOptionChains(
+	ctx context.Context, underlying string, expiration string,
+) ([]*datasourcev1.Chain, error) {
+	type snapshot struct {
+		LatestQuote struct {
+			Ask     decimal `json:"ap"`
+			AskSize int32   `json:"as"`
+			Bid     decimal `json:"bp"`
+			BidSize int32   `json:"bs"`
+			Time    string  `json:"t"`
+		} `json:"latestQuote"`
+		LatestTrade *struct {
+			Price decimal `json:"p"`
+			Size  int64   `json:"s"`
+			Time  string  `json:"t"`
+		} `json:"latestTrade"`
+		DailyBar *struct {
+			Volume int64 `json:"v"`
+		} `json:"dailyBar"`
+		PrevDailyBar *struct {
+			Close decimal `json:"c"`
+		} `json:"prevDailyBar"`
+		Greeks struct {
+			Delta decimal `json:"delta"`
+			Gamma decimal `json:"gamma"`
+			Theta decimal `json:"theta"`
+			Vega  decimal `json:"vega"`
+		} `json:"greeks"`
+		ImpliedVolatility decimal `json:"impliedVolatility"`
+	}
+	chains := make(map[string]*datasourcev1.Chain)
+	pageToken := ""
+	for {
+		body := &struct {
+			Snapshots     map[string]*snapshot `json:"snapshots"`
+			NextPageToken *string              `json:"next_page_token"`
+		}{}
+		params := map[string]string{
+			"expiration_date": expiration,
+			"limit":           pageLimit,
+		}
+		if pageToken != "" {
+			params["page_token"] = pageToken
+		}
+		resp, err := a.data.R().
+			SetContext(ctx).
+			SetQueryParams(params).
+			SetResult(body).
+			Get("/v1beta1/options/snapshots/" + underlying)
+		if err := account.CheckResponse("get option chains", resp, err); err != nil {
+			return nil, err
+		}
+		for symbol, s := range body.Snapshots {
+			root, isCall, strike, err := parseOptionSymbol(symbol)
+			if err != nil {
+				return nil, account.MalformedError("get option chains", err)
+			}
+			quoteAt, err := parseTime("get option chains", s.LatestQuote.Time)
+			if err != nil {
+				return nil, err
+			}
+			chain, ok := chains[root]
+			if !ok {
+				chain = &datasourcev1.Chain{RootSymbol: root, Underlying: underlying, Expiration: expiration}
+				chains[root] = chain
+			}
+			option := &datasourcev1.Option{
+				Symbol:          symbol,
+				Strike:          strike,
+				Bid:             float64(s.LatestQuote.Bid),
+				BidSize:         s.LatestQuote.BidSize,
+				BidAt:           quoteAt,
+				Ask:             float64(s.LatestQuote.Ask),
+				AskSize:         s.LatestQuote.AskSize,
+				AskAt:           quoteAt,
+				QuoteAt:         quoteAt,
+				GreeksUpdatedAt: quoteAt,
+				Iv:              float64(s.ImpliedVolatility),
+				Delta:           float64(s.Greeks.Delta),
+				Gamma:           float64(s.Greeks.Gamma),
+				Vega:            float64(s.Greeks.Vega),
+				Theta:           float64(s.Greeks.Theta),
+			}
+			// snapshots have no open interest, it's only in the contracts
+			if trade := s.LatestTrade; trade != nil {
+				lastAt, err := parseTime("get option chains", trade.Time)
+				if err != nil {
+					return nil, err
+				}
+				option.Last = float64(trade.Price)
+				option.LastVolume = trade.Size
+				option.LastAt = lastAt
+				if s.PrevDailyBar != nil && s.PrevDailyBar.Close > 0 {
+					option.Change = float64(trade.Price - s.PrevDailyBar.Close)
+				}
+			}
+			if s.DailyBar != nil {
+				option.Volume = s.DailyBar.Volume
+			}
+			if isCall {
+				chain.Calls = append(chain.Calls, option)
+			} else {
+				chain.Puts = append(chain.Puts, option)
+			}
+		}
+		if body.NextPageToken == nil || *body.NextPageToken == "" {
+			break
+		}
+		pageToken = *body.NextPageToken
+	}
+	rets := make([]*datasourcev1.Chain, 0, len(chains))
+	for _, chain := range chains {
+		account.SortByStrikePrice(chain)
+		rets = append(rets, chain)
+	}
+	sort.Slice(
+		rets, func(i, j int) bool {
+			return rets[i].RootSymbol < rets[j].RootSymbol
+		},
+	)
+	return rets, nil
+}
+
+// GetOptionExpirations refer to https://docs.alpaca.markets/reference/get-options-contracts,
+// the expirations are collected from the active contracts
+// GetOptionExpirations lists the contracts of calls only, every expiration has calls,
+// and caches them for the day since expirations only change daily
+func (a *Alpaca) GetOptionExpirations(ctx context.Context, underlying string) ([]string, error) {
+	today := time.Now().In(util.TZNewYork).Format(time.DateOnly)
+	a.mu.Lock()
+	cached, ok := a.expirations[underlying]
+	a.mu.Unlock()
+	if ok && cached.date == today {
+		return slices.Clone(cached.list), nil
+	}
+	seen := make(map[string]bool)
+	expirations := make([]string, 0)
+	pageToken := ""
+	for {
+		body := &struct {
+			OptionContracts []struct {
+				ExpirationDate string `json:"expiration_date"` // YYYY-MM-DD
+			} `json:"option_contracts"`
+			NextPageToken *string `json:"next_page_token"`
+		}{}
+		params := map[string]string{
+			"underlying_symbols":  underlying,
+			"status":              "active",
+			"type":                "call",
+			"expiration_date_gte": today,
+			"limit":               pageLimit,
+		}
+		if pageToken != "" {
+			params["page_token"] = pageToken
+		}
+		resp, err := a.trading.R().
+			SetContext(ctx).
+			SetQueryParams(params).
+			SetResult(body).
+			Get("/v2/options/contracts")
+		if err := account.CheckResponse("get option expirations", resp, err); err != nil {
+			return nil, err
+		}
+		for _, contract := range body.OptionContracts {
+			if !seen[contract.ExpirationDate] {
+				seen[contract.ExpirationDate] = true
+				expirations = append(expirations, contract.ExpirationDate)
+			}
+		}
+		if body.NextPageToken == nil || *body.NextPageToken == "" {
+			break
+		}
+		pageToken = *body.NextPageToken
+	}
+	sort.Strings(expirations)
+	a.mu.Lock()
+	if a.expirations == nil {
+		a.expirations = make(map[string]dailyExpirations)
+	}
+	a.expirations[underlying] = dailyExpirations{date: today, list: expirations}
+	a.mu.Unlock()
+	return slices.Clone(expirations), nil
+}
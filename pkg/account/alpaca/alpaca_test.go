@@ -0,0 +1,140 @@
+package alpaca
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/account/accounttest"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorded responses of the alpaca apis, trimmed to the fields we use
+const (
+	contractsPage1   = `{"option_contracts":[{"symbol":"SPY240119P00460000","expiration_date":"2024-01-19","root_symbol":"SPY","type":"put","strike_price":"460"},{"symbol":"SPY240122P00460000","expiration_date":"2024-01-22","root_symbol":"SPY","type":"put","strike_price":"460"}],"next_page_token":"MTAwMA=="}`
+	contractsPage2   = `{"option_contracts":[{"symbol":"SPY240119C00480000","expiration_date":"2024-01-19","root_symbol":"SPY","type":"call","strike_price":"480"}],"next_page_token":null}`
+	snapshotsPage    = `{"snapshots":{"SPY240119P00460000":{"latestQuote":{"ap":2.6,"as":10,"bp":2.4,"bs":12,"t":"2024-01-19T15:59:59.5Z"},"latestTrade":{"p":2.5,"s":4,"t":"2024-01-19T15:58:00Z"},"dailyBar":{"v":1250},"prevDailyBar":{"c":3.25},"greeks":{"delta":-0.21,"gamma":0.02,"theta":-0.5,"vega":0.1},"impliedVolatility":0.14},"SPY240119C00480000":{"latestQuote":{"ap":1.1,"as":3,"bp":1.0,"bs":4,"t":"2024-01-19T15:59:59.5Z"},"greeks":{"delta":0.3,"gamma":0.03,"theta":-0.4,"vega":0.1},"impliedVolatility":0.12},"SPY240119P00450000":{"latestQuote":{"ap":1.3,"as":1,"bp":1.2,"bs":1,"t":"2024-01-19T15:59:59.5Z"}}},"next_page_token":null}`
+	stockSnapshots   = `{"SPY":{"latestTrade":{"p":482.43,"t":"2024-01-19T20:59:59.9Z"},"latestQuote":{"ap":482.44,"bp":482.42},"dailyBar":{"o":477.65,"h":482.72,"l":476.54,"c":482.43,"v":110834465},"prevDailyBar":{"c":476.49}}}`
+	calendarResponse = `[{"date":"2024-01-19","open":"09:30","close":"16:00","session_open":"0400","session_close":"2000","settlement_date":"2024-01-23"}]`
+	orderResponse    = `{"id":"b0b6dd9d","status":"filled","order_class":"mleg","filled_avg_price":"1.25","legs":[{"symbol":"SPY240119P00460000","side":"sell","ratio_qty":"1","filled_avg_price":"2.5"},{"symbol":"SPY240119P00450000","side":"buy","ratio_qty":"1","filled_avg_price":"1.25"}]}`
+	accountResponse  = `{"equity":"10000","cash":"5000","buying_power":"20000","options_buying_power":"4000"}`
+	positions        = `[{"symbol":"SPY","qty":"-100","asset_class":"us_equity"},{"symbol":"SPY240119P00450000","qty":"2","asset_class":"us_option"}]`
+)
+
+func TestAlpaca(t *testing.T) {
+	server := accounttest.NewServer(
+		t, func(r *http.Request) bool {
+			return r.Header.Get("APCA-API-KEY-ID") == "key" && r.Header.Get("APCA-API-SECRET-KEY") == "secret"
+		},
+	)
+	listed := 0
+	server.Mux.HandleFunc(
+		"/v2/options/contracts", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("type") == "call" {
+				listed++
+			}
+			if r.URL.Query().Get("page_token") == "" {
+				server.Respond(contractsPage1)(w, r)
+			} else {
+				server.Respond(contractsPage2)(w, r)
+			}
+		},
+	)
+	server.Handle("/v1beta1/options/snapshots/SPY", snapshotsPage)
+	server.Handle("/v2/stocks/snapshots", stockSnapshots)
+	server.Handle("/v2/calendar", calendarResponse)
+	server.Handle("/v2/orders/b0b6dd9d", orderResponse)
+	server.Handle("/v2/account", accountResponse)
+	server.Handle("/v2/positions", positions)
+	placed := server.Record("/v2/orders", server.Respond(`{"id":"b0b6dd9d","status":"accepted"}`))
+
+	alpaca := NewAlpaca(false, "key", "secret")
+	alpaca.trading.SetBaseURL(server.URL)
+	alpaca.data.SetBaseURL(server.URL)
+	ctx := context.Background()
+
+	expirations, err := alpaca.GetOptionExpirations(ctx, "SPY")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2024-01-19", "2024-01-22"}, expirations)
+	// the expirations are cached for the day
+	_, err = alpaca.GetOptionExpirations(ctx, "SPY")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, listed)
+
+	chains, err := alpaca.GetOptionChains(ctx, "SPY", "2024-01-19")
+	assert.NoError(t, err)
+	assert.Len(t, chains, 1)
+	chain := chains[0]
+	assert.Equal(t, "SPY", chain.RootSymbol)
+	assert.Len(t, chain.Calls, 1)
+	assert.Len(t, chain.Puts, 2)
+	assert.Equal(t, 450.0, chain.Puts[0].Strike)
+	assert.Equal(t, -0.21, chain.Puts[1].Delta)
+	assert.Equal(t, 0.14, chain.Puts[1].Iv)
+	assert.Equal(t, int32(12), chain.Puts[1].BidSize)
+	assert.Equal(t, 2.5, chain.Puts[1].Last)
+	assert.Equal(t, int64(4), chain.Puts[1].LastVolume)
+	assert.Equal(t, -0.75, chain.Puts[1].Change)
+	assert.Equal(t, int64(1250), chain.Puts[1].Volume)
+
+	quotes, err := alpaca.GetQuotes(ctx, []string{"SPY"})
+	assert.NoError(t, err)
+	assert.Equal(t, 482.43, quotes[0].Last)
+	assert.Equal(t, 476.49, quotes[0].PrevClose)
+	assert.Equal(t, 477.65, quotes[0].Open)
+
+	// the weekend is closed
+	periods, err := alpaca.GetTradeCalendar(ctx, "2024-01-19", "2024-01-21")
+	assert.NoError(t, err)
+	assert.Len(t, periods, 3)
+	assert.True(t, periods[0].IsOpen)
+	assert.Equal(t, int64(1705674600000), periods[0].OpenAt)
+	assert.False(t, periods[1].IsOpen)
+
+	id, err := alpaca.PlaceOrder(
+		ctx, &account.Order{
+			Underlying: "SPY",
+			Legs: []account.OrderLeg{
+				{Symbol: "SPY240119P00460000", Side: account.SellToOpen, Quantity: 2},
+				{Symbol: "SPY240119P00450000", Side: account.BuyToOpen, Quantity: 2},
+			},
+			Price: -1.2,
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "b0b6dd9d", id)
+	body := <-placed
+	assert.Equal(t, "mleg", body["order_class"])
+	assert.Equal(t, "2", body["qty"])
+	assert.Equal(t, "-1.20", body["limit_price"])
+	leg := body["legs"].([]any)[0].(map[string]any)
+	assert.Equal(t, "sell", leg["side"])
+	assert.Equal(t, "sell_to_open", leg["position_intent"])
+	assert.Equal(t, "1", leg["ratio_qty"])
+
+	result, err := alpaca.GetOrder(ctx, id)
+	assert.NoError(t, err)
+	assert.Equal(t, account.OrderStatusFilled, result.Status)
+	assert.InDelta(t, -1.25, result.FillPrice, 1e-9)
+	assert.Equal(t, 2.5, result.LegFillPrices["SPY240119P00460000"])
+
+	balances, err := alpaca.GetBalances(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 4000.0, balances.OptionBuyingPower)
+	assert.Equal(t, 10000.0, balances.TotalEquity)
+
+	holdings, err := alpaca.GetPositions(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, -100.0, holdings[0].Quantity)
+}
+
+func TestParseOptionSymbol(t *testing.T) {
+	root, isCall, strike, err := parseOptionSymbol("SPXW240119C04712500")
+	assert.NoError(t, err)
+	assert.Equal(t, "SPXW", root)
+	assert.True(t, isCall)
+	assert.Equal(t, 4712.5, strike)
+	_, _, _, err = parseOptionSymbol("SPY")
+	assert.Error(t, err)
+}
@@ -0,0 +1,232 @@
+package alpaca
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	accountv1 "github.com/ppaanngggg/option-bot/proto/gen/account/v1"
+	"golang.org/x/xerrors"
+)
+
+func init() {
+	account.RegisterFactory(
+		accountv1.AccountType_ACCOUNT_TYPE_ALPACA,
+		func(setting *accountv1.Setting) (account.Market, account.Broker, error) {
+			s := setting.GetAlpaca()
+			if s.GetApiKey() == "" || s.GetApiSecret() == "" {
+				return nil, nil, xerrors.New("alpaca api key and secret are required")
+			}
+			a := NewAlpaca(s.IsLive, s.ApiKey, s.ApiSecret)
+			return a, a, nil
+		},
+	)
+}
+
+var _ account.Broker = (*Alpaca)(nil)
+
+type orderLeg struct {
+	Symbol         string `json:"symbol"`
+	Side           string `json:"side"` // buy or sell
+	PositionIntent string `json:"position_intent"`
+	RatioQty       string `json:"ratio_qty,omitempty"`
+}
+
+// sideOf returns the side and the position intent of an order side, e.g. buy and buy_to_open
+func sideOf(side account.OrderSide) (string, string) {
+	intent := side.String()
+	if side == account.BuyToOpen || side == account.BuyToClose {
+		return "buy", intent
+	}
+	return "sell", intent
+}
+
+func gcd(a, b int32) int32 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// PlaceOrder refer to https://docs.alpaca.markets/reference/postorder,
+// a multi-leg order is placed as an mleg order of units, whose legs are the ratios of one unit
+func (a *Alpaca) PlaceOrder(ctx context.Context, order *account.Order) (string, error) {
+	if len(order.Legs) == 0 {
+		return "", xerrors.New("order has no legs")
+	}
+	body := map[string]any{
+		"time_in_force": "day",
+	}
+	if order.IsMarket {
+		body["type"] = "market"
+	} else {
+		body["type"] = "limit"
+	}
+	if len(order.Legs) == 1 {
+		leg := order.Legs[0]
+		side, intent := sideOf(leg.Side)
+		body["symbol"] = leg.Symbol
+		body["qty"] = strconv.Itoa(int(leg.Quantity))
+		body["side"] = side
+		body["position_intent"] = intent
+		if !order.IsMarket {
+			body["limit_price"] = formatPrice(math.Abs(order.Price))
+		}
+	} else {
+		units := int32(0)
+		for _, leg := range order.Legs {
+			units = gcd(units, leg.Quantity)
+		}
+		legs := make([]orderLeg, 0, len(order.Legs))
+		for _, leg := range order.Legs {
+			side, intent := sideOf(leg.Side)
+			legs = append(
+				legs, orderLeg{
+					Symbol:         leg.Symbol,
+					Side:           side,
+					PositionIntent: intent,
+					RatioQty:       strconv.Itoa(int(leg.Quantity / units)),
+				},
+			)
+		}
+		body["order_class"] = "mleg"
+		body["qty"] = strconv.Itoa(int(units))
+		body["legs"] = legs
+		if !order.IsMarket {
+			// positive for debit and negative for credit, the same as ours
+			body["limit_price"] = formatPrice(order.Price)
+		}
+	}
+	result := &struct {
+		ID     string `json:"id"`
+		Status string `json:"status"`
+	}{}
+	resp, err := a.trading.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		SetResult(result).
+		Post("/v2/orders")
+	if err := account.CheckResponse("place order", resp, err); err != nil {
+		return "", err
+	}
+	if result.ID == "" {
+		return "", account.MalformedError("place order", xerrors.New("no order id"))
+	}
+	return result.ID, nil
+}
+
+// GetOrder refer to https://docs.alpaca.markets/reference/getorderbyorderid
+func (a *Alpaca) GetOrder(ctx context.Context, id string) (*account.OrderResult, error) {
+	type leg struct {
+		Symbol         string  `json:"symbol"`
+		Side           string  `json:"side"`
+		RatioQty       decimal `json:"ratio_qty"`
+		FilledAvgPrice decimal `json:"filled_avg_price"`
+		FilledQty      decimal `json:"filled_qty"`
+	}
+	body := &struct {
+		leg
+		Status string `json:"status"`
+		Legs   []leg  `json:"legs"`
+	}{}
+	resp, err := a.trading.R().
+		SetContext(ctx).
+		SetResult(body).
+		Get("/v2/orders/" + id)
+	if err := account.CheckResponse("get order", resp, err); err != nil {
+		return nil, err
+	}
+	result := &account.OrderResult{
+		ID: id, LegFillPrices: make(map[string]float64), LegFilledQuantities: make(map[string]float64),
+	}
+	switch body.Status {
+	case "filled":
+		result.Status = account.OrderStatusFilled
+	case "partially_filled":
+		result.Status = account.OrderStatusPartiallyFilled
+	case "canceled", "replaced", "done_for_day":
+		result.Status = account.OrderStatusCanceled
+	case "expired":
+		result.Status = account.OrderStatusExpired
+	case "rejected", "suspended":
+		result.Status = account.OrderStatusRejected
+	default:
+		result.Status = account.OrderStatusPending
+	}
+	legs := body.Legs
+	if len(legs) == 0 {
+		single := body.leg
+		single.RatioQty = 1
+		legs = []leg{single}
+	}
+	// the net price per share of one unit
+	for _, l := range legs {
+		price := float64(l.FilledAvgPrice)
+		if price == 0 {
+			continue
+		}
+		result.LegFillPrices[l.Symbol] = price
+		result.LegFilledQuantities[l.Symbol] = float64(l.FilledQty)
+		sign := 1.0
+		if l.Side == "sell" {
+			sign = -1
+		}
+		result.FillPrice += sign * price * float64(l.RatioQty)
+	}
+	return result, nil
+}
+
+// CancelOrder refer to https://docs.alpaca.markets/reference/deleteorderbyorderid
+func (a *Alpaca) CancelOrder(ctx context.Context, id string) error {
+	resp, err := a.trading.R().
+		SetContext(ctx).
+		Delete("/v2/orders/" + id)
+	return account.CheckResponse("cancel order", resp, err)
+}
+
+// GetBalances refer to https://docs.alpaca.markets/reference/getaccount-1
+func (a *Alpaca) GetBalances(ctx context.Context) (*account.Balances, error) {
+	body := &struct {
+		Equity             decimal `json:"equity"`
+		Cash               decimal `json:"cash"`
+		OptionsBuyingPower decimal `json:"options_buying_power"`
+	}{}
+	resp, err := a.trading.R().
+		SetContext(ctx).
+		SetResult(body).
+		Get("/v2/account")
+	if err := account.CheckResponse("get balances", resp, err); err != nil {
+		return nil, err
+	}
+	return &account.Balances{
+		TotalEquity:       float64(body.Equity),
+		CashAvailable:     float64(body.Cash),
+		OptionBuyingPower: float64(body.OptionsBuyingPower),
+	}, nil
+}
+
+// GetPositions refer to https://docs.alpaca.markets/reference/getallopenpositions
+func (a *Alpaca) GetPositions(ctx context.Context) ([]account.Holding, error) {
+	var body []struct {
+		Symbol string  `json:"symbol"`
+		Qty    decimal `json:"qty"` // negative for short
+	}
+	resp, err := a.trading.R().
+		SetContext(ctx).
+		SetResult(&body).
+		Get("/v2/positions")
+	if err := account.CheckResponse("get positions", resp, err); err != nil {
+		return nil, err
+	}
+	holdings := make([]account.Holding, 0, len(body))
+	for _, p := range body {
+		holdings = append(holdings, account.Holding{Symbol: p.Symbol, Quantity: float64(p.Qty)})
+	}
+	return holdings, nil
+}
+
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', 2, 64)
+}
@@ -0,0 +1,95 @@
+package account
+
+import "context"
+
+type OrderSide int
+
+const (
+	BuyToOpen OrderSide = iota
+	SellToOpen
+	BuyToClose
+	SellToClose
+)
+
+func (s OrderSide) String() string {
+	switch s {
+	case BuyToOpen:
+		return "buy_to_open"
+	case SellToOpen:
+		return "sell_to_open"
+	case BuyToClose:
+		return "buy_to_close"
+	case SellToClose:
+		return "sell_to_close"
+	}
+	return "unknown"
+}
+
+type OrderLeg struct {
+	// option symbol, e.g. SPXW240119C04700000
+	Symbol   string
+	Side     OrderSide
+	Quantity int32
+}
+
+// Order is a single or multi leg option order of one underlying
+type Order struct {
+	Underlying string
+	Legs       []OrderLeg
+	// net limit price per share, positive for debit, negative for credit and 0 for even,
+	// ignored by a market order
+	Price    float64
+	IsMarket bool
+}
+
+type OrderStatus int
+
+const (
+	OrderStatusPending OrderStatus = iota
+	OrderStatusPartiallyFilled
+	OrderStatusFilled
+	OrderStatusCanceled
+	OrderStatusRejected
+	OrderStatusExpired
+)
+
+// IsDone is true if the order will never change again
+func (s OrderStatus) IsDone() bool {
+	return s == OrderStatusFilled || s == OrderStatusCanceled ||
+		s == OrderStatusRejected || s == OrderStatusExpired
+}
+
+type OrderResult struct {
+	ID     string
+	Status OrderStatus
+	// net average fill price per share, positive for debit and negative for credit
+	FillPrice float64
+	// average fill price per share of each leg by symbol
+	LegFillPrices map[string]float64
+	// filled contracts of each leg by symbol, unsigned, tells how much of a partially filled order is filled
+	LegFilledQuantities map[string]float64
+}
+
+type Balances struct {
+	TotalEquity       float64
+	CashAvailable     float64
+	OptionBuyingPower float64
+}
+
+// Holding is a position held by the account
+type Holding struct {
+	// stock symbol or option symbol
+	Symbol string
+	// signed quantity, negative for short
+	Quantity float64
+}
+
+// Broker places orders of an account
+type Broker interface {
+	// PlaceOrder returns the id of the order
+	PlaceOrder(ctx context.Context, order *Order) (string, error)
+	GetOrder(ctx context.Context, id string) (*OrderResult, error)
+	CancelOrder(ctx context.Context, id string) error
+	GetBalances(ctx context.Context) (*Balances, error)
+	GetPositions(ctx context.Context) ([]Holding, error)
+}
@@ -0,0 +1,295 @@
+package account
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+)
+
+// sweep expired entries once the cache grows over this size
+const cacheSweepSize = 1024
+
+type CacheOptions struct {
+	// TTL of each kind of response, zero disables caching for that kind
+	SearchTTL     time.Duration
+	ExpirationTTL time.Duration
+	ChainTTL      time.Duration
+	CalendarTTL   time.Duration
+	// token bucket of the upstream calls, refilled RatePerMinute per minute up to Burst
+	RatePerMinute int
+	Burst         int
+	// timeout of one upstream call, including the wait for the limiter, zero disables it
+	FetchTimeout time.Duration
+}
+
+// DefaultCacheOptions reads the options from util.Conf
+func DefaultCacheOptions() CacheOptions {
+	return CacheOptions{
+		SearchTTL:     util.Conf.Market.SearchTTL,
+		ExpirationTTL: util.Conf.Market.ExpirationTTL,
+		ChainTTL:      util.Conf.Market.ChainTTL,
+		CalendarTTL:   util.Conf.Market.CalendarTTL,
+		RatePerMinute: util.Conf.Market.RatePerMinute,
+		Burst:         util.Conf.Market.Burst,
+		FetchTimeout:  util.Conf.Market.FetchTimeout,
+	}
+}
+
+// CacheStat counts hits and misses of one method, coalesced calls count as hits
+type CacheStat struct {
+	Hits   int64
+	Misses int64
+}
+
+type cacheEntry struct {
+	value     any
+	expiresAt time.Time
+}
+
+type cacheCounter struct {
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+var _ Market = (*CachedMarket)(nil)
+
+// CachedMarket decorates a Market of one account with TTL caching, coalescing of concurrent
+// identical calls and a token bucket limiter, to stay under the broker's request limits
+type CachedMarket struct {
+	market  Market
+	opts    CacheOptions
+	limiter *rate.Limiter
+	group   singleflight.Group
+
+	mu       sync.Mutex
+	entries  map[string]*cacheEntry
+	counters map[string]*cacheCounter
+}
+
+func NewCachedMarket(market Market, opts CacheOptions) *CachedMarket {
+	limit := rate.Inf
+	if opts.RatePerMinute > 0 {
+		limit = rate.Limit(float64(opts.RatePerMinute) / 60)
+	}
+	return &CachedMarket{
+		market:   market,
+		opts:     opts,
+		limiter:  rate.NewLimiter(limit, max(opts.Burst, 1)),
+		entries:  make(map[string]*cacheEntry),
+		counters: make(map[string]*cacheCounter),
+	}
+}
+
+// Stats returns hits and misses keyed by method name
+func (c *CachedMarket) Stats() map[string]CacheStat {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := make(map[string]CacheStat, len(c.counters))
+	for method, counter := range c.counters {
+		stats[method] = CacheStat{Hits: counter.hits.Load(), Misses: counter.misses.Load()}
+	}
+	return stats
+}
+
+func (c *CachedMarket) counter(method string) *cacheCounter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	counter, ok := c.counters[method]
+	if !ok {
+		counter = &cacheCounter{}
+		c.counters[method] = counter
+	}
+	return counter
+}
+
+func (c *CachedMarket) get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *CachedMarket) set(key string, value any, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if len(c.entries) >= cacheSweepSize {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	c.entries[key] = &cacheEntry{value: value, expiresAt: now.Add(ttl)}
+}
+
+// cached returns the cached value of key, or calls fetch once for all concurrent callers,
+// errors are never cached. The fetch is detached from the callers, so a canceled caller doesn't
+// fail the others, and every caller stops waiting once its own ctx is done.
+func cached[T any](
+	ctx context.Context, c *CachedMarket, method string, ttl time.Duration,
+	fetch func(ctx context.Context) (T, error), args ...string,
+) (T, error) {
+	counter := c.counter(method)
+	key := method + "|" + strings.Join(args, "|")
+	if ttl > 0 {
+		if value, ok := c.get(key); ok {
+			counter.hits.Add(1)
+			return value.(T), nil
+		}
+	}
+	// only the caller whose fetch runs counts a miss, the coalesced ones count hits
+	missed := atomic.Bool{}
+	results := c.group.DoChan(
+		key, func() (any, error) {
+			missed.Store(true)
+			// keep the values of the first caller's ctx, e.g. the logger, but not its cancellation
+			ctx := context.WithoutCancel(ctx)
+			if c.opts.FetchTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, c.opts.FetchTimeout)
+				defer cancel()
+			}
+			if err := c.limiter.Wait(ctx); err != nil {
+				return nil, xerrors.Errorf("rate limiter: %w", err)
+			}
+			value, err := fetch(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if ttl > 0 {
+				c.set(key, value, ttl)
+			}
+			return value, nil
+		},
+	)
+	var zero T
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case result := <-results:
+		if missed.Load() {
+			counter.misses.Add(1)
+		} else {
+			counter.hits.Add(1)
+		}
+		if result.Err != nil {
+			return zero, result.Err
+		}
+		return result.Val.(T), nil
+	}
+}
+
+func (c *CachedMarket) Search(ctx context.Context, query string) (
+	[]*datasourcev1.Symbol, error,
+) {
+	symbols, err := cached(
+		ctx, c, "Search", c.opts.SearchTTL,
+		func(ctx context.Context) ([]*datasourcev1.Symbol, error) {
+			return c.market.Search(ctx, query)
+		}, query,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return cloneMessages(symbols), nil
+}
+
+func (c *CachedMarket) GetOptionExpirations(ctx context.Context, underlying string) (
+	[]string, error,
+) {
+	expirations, err := cached(
+		ctx, c, "GetOptionExpirations", c.opts.ExpirationTTL,
+		func(ctx context.Context) ([]string, error) {
+			return c.market.GetOptionExpirations(ctx, underlying)
+		}, underlying,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string(nil), expirations...), nil
+}
+
+func (c *CachedMarket) GetOptionChains(
+	ctx context.Context, underlying string, expiration string,
+) ([]*datasourcev1.Chain, error) {
+	chains, err := cached(
+		ctx, c, "GetOptionChains", c.opts.ChainTTL,
+		func(ctx context.Context) ([]*datasourcev1.Chain, error) {
+			return c.market.GetOptionChains(ctx, underlying, expiration)
+		}, underlying, expiration,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return cloneMessages(chains), nil
+}
+
+// GetQuotes shares the TTL of chains, since quotes move as fast as options
+func (c *CachedMarket) GetQuotes(ctx context.Context, symbols []string) (
+	[]*datasourcev1.Quote, error,
+) {
+	quotes, err := cached(
+		ctx, c, "GetQuotes", c.opts.ChainTTL,
+		func(ctx context.Context) ([]*datasourcev1.Quote, error) {
+			return c.market.GetQuotes(ctx, symbols)
+		}, symbols...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return cloneMessages(quotes), nil
+}
+
+func (c *CachedMarket) GetTodayTradePeriod(ctx context.Context) (
+	*datasourcev1.TradePeriod, error,
+) {
+	// keyed by date, so that the cache never answers with yesterday's period
+	today := time.Now().In(util.TZNewYork).Format("2006-01-02")
+	period, err := cached(
+		ctx, c, "GetTodayTradePeriod", c.opts.CalendarTTL,
+		func(ctx context.Context) (*datasourcev1.TradePeriod, error) {
+			return c.market.GetTodayTradePeriod(ctx)
+		}, today,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(period).(*datasourcev1.TradePeriod), nil
+}
+
+func (c *CachedMarket) GetTradeCalendar(
+	ctx context.Context, from string, to string,
+) ([]*datasourcev1.TradePeriod, error) {
+	periods, err := cached(
+		ctx, c, "GetTradeCalendar", c.opts.CalendarTTL,
+		func(ctx context.Context) ([]*datasourcev1.TradePeriod, error) {
+			return c.market.GetTradeCalendar(ctx, from, to)
+		}, from, to,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return cloneMessages(periods), nil
+}
+
+// cloneMessages deep copies cached messages, so that callers are free to modify them
+func cloneMessages[T proto.Message](msgs []T) []T {
+	rets := make([]T, 0, len(msgs))
+	for _, msg := range msgs {
+		rets = append(rets, proto.Clone(msg).(T))
+	}
+	return rets
+}
@@ -0,0 +1,101 @@
+package account
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// slowMarket counts upstream calls and takes a while to answer, to let concurrent calls overlap
+type slowMarket struct {
+	Market
+	calls atomic.Int32
+}
+
+func (m *slowMarket) GetOptionChains(
+	ctx context.Context, underlying string, expiration string,
+) ([]*datasourcev1.Chain, error) {
+	m.calls.Add(1)
+	time.Sleep(20 * time.Millisecond)
+	return []*datasourcev1.Chain{
+		{Underlying: underlying, Expiration: expiration},
+	}, nil
+}
+
+func TestCachedMarket_GetOptionChains(t *testing.T) {
+	upstream := &slowMarket{}
+	market := NewCachedMarket(upstream, CacheOptions{ChainTTL: time.Minute})
+	ctx := context.Background()
+
+	// concurrent identical calls are coalesced into one upstream call
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			chains, err := market.GetOptionChains(ctx, "SPX", "2024-01-19")
+			assert.NoError(t, err)
+			assert.Equal(t, "SPX", chains[0].Underlying)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), upstream.calls.Load())
+
+	// cached values are copies, modifying them doesn't affect the cache
+	chains, err := market.GetOptionChains(ctx, "SPX", "2024-01-19")
+	assert.NoError(t, err)
+	chains[0].Underlying = "modified"
+	chains, err = market.GetOptionChains(ctx, "SPX", "2024-01-19")
+	assert.NoError(t, err)
+	assert.Equal(t, "SPX", chains[0].Underlying)
+	assert.Equal(t, int32(1), upstream.calls.Load())
+
+	// a different key is a miss
+	_, err = market.GetOptionChains(ctx, "SPX", "2024-01-26")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), upstream.calls.Load())
+
+	stat := market.Stats()["GetOptionChains"]
+	assert.Equal(t, int64(8), stat.Hits+stat.Misses)
+	assert.Equal(t, int64(upstream.calls.Load()), stat.Misses)
+}
+
+func TestCachedMarket_Canceled(t *testing.T) {
+	upstream := &slowMarket{}
+	market := NewCachedMarket(upstream, CacheOptions{ChainTTL: time.Minute, FetchTimeout: time.Second})
+
+	// the first caller leaves, the coalesced caller still gets the chains
+	canceled, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := market.GetOptionChains(canceled, "SPX", "2024-01-19")
+		errs <- err
+	}()
+	time.Sleep(5 * time.Millisecond)
+	cancel()
+	chains, err := market.GetOptionChains(context.Background(), "SPX", "2024-01-19")
+	assert.NoError(t, err)
+	assert.Equal(t, "SPX", chains[0].Underlying)
+	assert.ErrorIs(t, <-errs, context.Canceled)
+	assert.Equal(t, int32(1), upstream.calls.Load())
+}
+
+func TestCachedMarket_RateLimit(t *testing.T) {
+	upstream := &slowMarket{}
+	// no cache, 1 request per second after a burst of 1
+	market := NewCachedMarket(upstream, CacheOptions{RatePerMinute: 60, Burst: 1})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := market.GetOptionChains(ctx, "SPX", "2024-01-19")
+	assert.NoError(t, err)
+	// the bucket is empty, the second call can't get a token before ctx is done
+	_, err = market.GetOptionChains(ctx, "SPX", "2024-01-19")
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), upstream.calls.Load())
+}
@@ -0,0 +1,55 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+)
+
+var _ Market = (*CalendarFallback)(nil)
+
+// CalendarFallback answers the calendar by NYSECalendar when the wrapped Market fails,
+// so that DTE math and schedulers keep working while the broker is unreachable.
+// Wrap it outside of CachedMarket, so that the fallback answers are never cached.
+type CalendarFallback struct {
+	Market
+	logger slog.Logger
+}
+
+func NewCalendarFallback(market Market) *CalendarFallback {
+	return &CalendarFallback{
+		Market: market,
+		logger: util.DefaultLogger.With(slog.F("account", "calendar_fallback")),
+	}
+}
+
+func (c *CalendarFallback) GetTodayTradePeriod(ctx context.Context) (
+	*datasourcev1.TradePeriod, error,
+) {
+	period, err := c.Market.GetTodayTradePeriod(ctx)
+	if err == nil {
+		return period, nil
+	}
+	if ctx.Err() != nil {
+		return nil, err
+	}
+	c.logger.Warn(ctx, "fallback to the NYSE calendar", slog.Error(err))
+	return NYSETradePeriod(time.Now()), nil
+}
+
+func (c *CalendarFallback) GetTradeCalendar(
+	ctx context.Context, from string, to string,
+) ([]*datasourcev1.TradePeriod, error) {
+	periods, err := c.Market.GetTradeCalendar(ctx, from, to)
+	if err == nil {
+		return periods, nil
+	}
+	if ctx.Err() != nil {
+		return nil, err
+	}
+	c.logger.Warn(ctx, "fallback to the NYSE calendar", slog.Error(err))
+	return NYSECalendar(from, to)
+}
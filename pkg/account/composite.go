@@ -0,0 +1,365 @@
+package account
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+)
+
+// Source is one named market of a CompositeMarket, usually named by the account id
+type Source struct {
+	Name   string
+	Market Market
+}
+
+type CompositeOptions struct {
+	// quotes or greeks older than these are stale and fail over to the next source, zero disables the check
+	MaxQuoteAge  time.Duration
+	MaxGreeksAge time.Duration
+	// a failed source is tried after the healthy ones for this long
+	Cooldown time.Duration
+	// the source whose greeks replace the greeks of the option chains, empty disables merging
+	GreeksSource string
+}
+
+// DefaultCompositeOptions reads the options from util.Conf
+func DefaultCompositeOptions() CompositeOptions {
+	return CompositeOptions{
+		MaxQuoteAge:  util.Conf.DataSource.MaxQuoteAge,
+		MaxGreeksAge: util.Conf.DataSource.MaxGreeksAge,
+		Cooldown:     util.Conf.DataSource.FailoverCooldown,
+	}
+}
+
+// SourceHealth is the health of one source, failures are errors and stale answers are counted apart
+type SourceHealth struct {
+	Name                string
+	Healthy             bool
+	ConsecutiveFailures int64
+	Calls               int64
+	Failures            int64
+	Stales              int64
+	LastError           string
+	LastErrorAt         time.Time
+	LastSuccessAt       time.Time
+	LastStaleAt         time.Time
+	CoolingUntil        time.Time
+	// the last answer was stale
+	Stale bool
+}
+
+type source struct {
+	Source
+	mu     sync.Mutex
+	health SourceHealth
+}
+
+func (s *source) cooling(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return now.Before(s.health.CoolingUntil)
+}
+
+func (s *source) succeed(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.health.Calls++
+	s.health.ConsecutiveFailures = 0
+	s.health.LastSuccessAt = now
+	s.health.CoolingUntil = time.Time{}
+	s.health.Stale = false
+}
+
+func (s *source) fail(now time.Time, err error, cooldown time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.health.Calls++
+	s.health.Failures++
+	s.health.ConsecutiveFailures++
+	s.health.LastError = err.Error()
+	s.health.LastErrorAt = now
+	s.health.CoolingUntil = now.Add(cooldown)
+}
+
+// stale cools the source down like a failure, out of session every source is stale and cooling,
+// so they keep their order
+func (s *source) stale(now time.Time, cooldown time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.health.Calls++
+	s.health.Stales++
+	s.health.LastStaleAt = now
+	s.health.CoolingUntil = now.Add(cooldown)
+	s.health.Stale = true
+}
+
+var _ Market = (*CompositeMarket)(nil)
+
+// CompositeMarket tries an ordered list of markets, fails over to the next one when a source errors
+// or answers stale quotes, and optionally merges the greeks of another source into the option chains.
+// Wrap every source by its own CachedMarket, since the request limits are per account.
+type CompositeMarket struct {
+	sources []*source
+	opts    CompositeOptions
+	now     func() time.Time
+}
+
+func NewCompositeMarket(sources []Source, opts CompositeOptions) *CompositeMarket {
+	c := &CompositeMarket{opts: opts, now: time.Now}
+	for _, s := range sources {
+		c.sources = append(c.sources, &source{Source: s, health: SourceHealth{Name: s.Name}})
+	}
+	return c
+}
+
+// Health returns the health of every source in order
+func (c *CompositeMarket) Health() []SourceHealth {
+	healths := make([]SourceHealth, 0, len(c.sources))
+	for _, s := range c.sources {
+		s.mu.Lock()
+		health := s.health
+		s.mu.Unlock()
+		health.Healthy = health.ConsecutiveFailures == 0 && !health.Stale
+		healths = append(healths, health)
+	}
+	return healths
+}
+
+// order returns the sources to try, the cooling ones are the last resort
+func (c *CompositeMarket) order(now time.Time) []*source {
+	sources := make([]*source, 0, len(c.sources))
+	var cooling []*source
+	for _, s := range c.sources {
+		if s.cooling(now) {
+			cooling = append(cooling, s)
+		} else {
+			sources = append(sources, s)
+		}
+	}
+	return append(sources, cooling...)
+}
+
+// failover returns the first fresh answer of the sources, or the freshest one when all are stale.
+// freshness returns the time of the answer and whether it's stale, nil skips the check.
+func failover[T any](
+	ctx context.Context, c *CompositeMarket,
+	call func(ctx context.Context, market Market) (T, error),
+	freshness func(value T, now time.Time) (time.Time, bool),
+) (T, *source, error) {
+	var (
+		best       T
+		bestSource *source
+		bestAt     time.Time
+		lastErr    error
+	)
+	for _, s := range c.order(c.now()) {
+		value, err := call(ctx, s.Market)
+		now := c.now()
+		if err != nil {
+			if ctx.Err() != nil {
+				var zero T
+				return zero, nil, err
+			}
+			s.fail(now, err, c.opts.Cooldown)
+			lastErr = xerrors.Errorf("source %s: %w", s.Name, err)
+			continue
+		}
+		if freshness == nil {
+			s.succeed(now)
+			return value, s, nil
+		}
+		at, stale := freshness(value, now)
+		if !stale {
+			s.succeed(now)
+			return value, s, nil
+		}
+		s.stale(now, c.opts.Cooldown)
+		if bestSource == nil || at.After(bestAt) {
+			best, bestSource, bestAt = value, s, at
+		}
+	}
+	if bestSource != nil {
+		return best, bestSource, nil
+	}
+	if lastErr == nil {
+		lastErr = xerrors.New("no market source")
+	}
+	var zero T
+	return zero, nil, lastErr
+}
+
+// quotedAt is the time of the latest side of the option, the time of the quote only when both sides
+// have no time, since some brokers stamp the quote when it's fetched
+func quotedAt(o *datasourcev1.Option) int64 {
+	if at := max(o.BidAt, o.AskAt); at > 0 {
+		return at
+	}
+	return o.QuoteAt
+}
+
+// isStale checks a unix timestamp in ms, unknown timestamps are never stale
+func isStale(at int64, now time.Time, maxAge time.Duration) bool {
+	return maxAge > 0 && at > 0 && now.Sub(time.UnixMilli(at)) > maxAge
+}
+
+func (c *CompositeMarket) Search(ctx context.Context, query string) (
+	[]*datasourcev1.Symbol, error,
+) {
+	symbols, _, err := failover(
+		ctx, c, func(ctx context.Context, market Market) ([]*datasourcev1.Symbol, error) {
+			return market.Search(ctx, query)
+		}, nil,
+	)
+	return symbols, err
+}
+
+func (c *CompositeMarket) GetOptionExpirations(ctx context.Context, underlying string) (
+	[]string, error,
+) {
+	expirations, _, err := failover(
+		ctx, c, func(ctx context.Context, market Market) ([]string, error) {
+			return market.GetOptionExpirations(ctx, underlying)
+		}, nil,
+	)
+	return expirations, err
+}
+
+// chainsFreshness judges the chains by their latest quote, and by their latest greeks unless merged
+func (c *CompositeMarket) chainsFreshness(chains []*datasourcev1.Chain, now time.Time) (
+	time.Time, bool,
+) {
+	var quoteAt, greeksAt int64
+	for _, chain := range chains {
+		for _, options := range [][]*datasourcev1.Option{chain.Calls, chain.Puts} {
+			for _, o := range options {
+				quoteAt = max(quoteAt, quotedAt(o))
+				greeksAt = max(greeksAt, o.GreeksUpdatedAt)
+			}
+		}
+	}
+	stale := isStale(quoteAt, now, c.opts.MaxQuoteAge)
+	if c.opts.GreeksSource == "" {
+		stale = stale || isStale(greeksAt, now, c.opts.MaxGreeksAge)
+	}
+	return time.UnixMilli(quoteAt), stale
+}
+
+func (c *CompositeMarket) GetOptionChains(
+	ctx context.Context, underlying string, expiration string,
+) ([]*datasourcev1.Chain, error) {
+	chains, served, err := failover(
+		ctx, c, func(ctx context.Context, market Market) ([]*datasourcev1.Chain, error) {
+			return market.GetOptionChains(ctx, underlying, expiration)
+		}, c.chainsFreshness,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if c.opts.GreeksSource != "" && c.opts.GreeksSource != served.Name {
+		c.mergeGreeks(ctx, chains, underlying, expiration)
+	}
+	return chains, nil
+}
+
+// mergeGreeks replaces the greeks of chains by the ones of the greeks source, matched by symbol.
+// The served greeks are kept when the greeks source fails or its greeks are stale.
+func (c *CompositeMarket) mergeGreeks(
+	ctx context.Context, chains []*datasourcev1.Chain, underlying string, expiration string,
+) {
+	var greeks *source
+	for _, s := range c.sources {
+		if s.Name == c.opts.GreeksSource {
+			greeks = s
+		}
+	}
+	if greeks == nil {
+		return
+	}
+	others, err := greeks.Market.GetOptionChains(ctx, underlying, expiration)
+	now := c.now()
+	if err != nil {
+		if ctx.Err() == nil {
+			greeks.fail(now, err, c.opts.Cooldown)
+		}
+		return
+	}
+	bySymbol := make(map[string]*datasourcev1.Option)
+	for _, chain := range others {
+		for _, options := range [][]*datasourcev1.Option{chain.Calls, chain.Puts} {
+			for _, o := range options {
+				if o.GreeksUpdatedAt > 0 && !isStale(o.GreeksUpdatedAt, now, c.opts.MaxGreeksAge) {
+					bySymbol[o.Symbol] = o
+				}
+			}
+		}
+	}
+	if len(bySymbol) == 0 {
+		greeks.stale(now, c.opts.Cooldown)
+		return
+	}
+	greeks.succeed(now)
+	for _, chain := range chains {
+		for _, options := range [][]*datasourcev1.Option{chain.Calls, chain.Puts} {
+			for _, o := range options {
+				other, ok := bySymbol[o.Symbol]
+				if !ok {
+					continue
+				}
+				o.GreeksUpdatedAt = other.GreeksUpdatedAt
+				o.Iv = other.Iv
+				o.Delta = other.Delta
+				o.Gamma = other.Gamma
+				o.Vega = other.Vega
+				o.Theta = other.Theta
+				o.MidIv = other.MidIv
+				o.AskIv = other.AskIv
+			}
+		}
+	}
+}
+
+// GetQuotes judges the quotes by the oldest one, so that no symbol is served stale
+func (c *CompositeMarket) GetQuotes(ctx context.Context, symbols []string) (
+	[]*datasourcev1.Quote, error,
+) {
+	quotes, _, err := failover(
+		ctx, c, func(ctx context.Context, market Market) ([]*datasourcev1.Quote, error) {
+			return market.GetQuotes(ctx, symbols)
+		}, func(quotes []*datasourcev1.Quote, now time.Time) (time.Time, bool) {
+			var oldest int64
+			for _, q := range quotes {
+				if q.QuoteAt > 0 && (oldest == 0 || q.QuoteAt < oldest) {
+					oldest = q.QuoteAt
+				}
+			}
+			return time.UnixMilli(oldest), isStale(oldest, now, c.opts.MaxQuoteAge)
+		},
+	)
+	return quotes, err
+}
+
+func (c *CompositeMarket) GetTodayTradePeriod(ctx context.Context) (
+	*datasourcev1.TradePeriod, error,
+) {
+	period, _, err := failover(
+		ctx, c, func(ctx context.Context, market Market) (*datasourcev1.TradePeriod, error) {
+			return market.GetTodayTradePeriod(ctx)
+		}, nil,
+	)
+	return period, err
+}
+
+func (c *CompositeMarket) GetTradeCalendar(
+	ctx context.Context, from string, to string,
+) ([]*datasourcev1.TradePeriod, error) {
+	periods, _, err := failover(
+		ctx, c, func(ctx context.Context, market Market) ([]*datasourcev1.TradePeriod, error) {
+			return market.GetTradeCalendar(ctx, from, to)
+		}, nil,
+	)
+	return periods, err
+}
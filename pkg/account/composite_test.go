@@ -0,0 +1,115 @@
+package account
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/xerrors"
+)
+
+// fixedMarket answers one put at the given time, or the error if set
+type fixedMarket struct {
+	Market
+	err   error
+	at    time.Time
+	delta float64
+	calls int
+	// stamps the quote when fetched and the sides at the time, if set
+	fetchedAt time.Time
+}
+
+func (m *fixedMarket) GetOptionChains(
+	ctx context.Context, underlying string, expiration string,
+) ([]*datasourcev1.Chain, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	put := &datasourcev1.Option{
+		Symbol:          "SPXW240119P04700000",
+		Bid:             1,
+		QuoteAt:         m.at.UnixMilli(),
+		GreeksUpdatedAt: m.at.UnixMilli(),
+		Delta:           m.delta,
+	}
+	if !m.fetchedAt.IsZero() {
+		put.BidAt, put.AskAt, put.QuoteAt = m.at.UnixMilli(), m.at.UnixMilli(), m.fetchedAt.UnixMilli()
+	}
+	return []*datasourcev1.Chain{
+		{
+			Underlying: underlying,
+			Expiration: expiration,
+			Puts:       []*datasourcev1.Option{put},
+		},
+	}, nil
+}
+
+func TestCompositeMarket_GetOptionChains(t *testing.T) {
+	now := time.Date(2024, 1, 19, 15, 0, 0, 0, time.UTC)
+	primary := &fixedMarket{err: &Error{Kind: ErrorKindUpstream, Op: "get option chains", StatusCode: 502}}
+	// the sides are stale, though the quote is stamped when fetched
+	stale := &fixedMarket{at: now.Add(-10 * time.Minute), delta: -0.2, fetchedAt: now}
+	fresh := &fixedMarket{at: now.Add(-time.Second), delta: -0.1}
+	market := NewCompositeMarket(
+		[]Source{{"primary", primary}, {"stale", stale}, {"fresh", fresh}},
+		CompositeOptions{MaxQuoteAge: time.Minute, MaxGreeksAge: time.Minute, Cooldown: time.Minute},
+	)
+	market.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// the failed and the stale sources fail over to the fresh one
+	chains, err := market.GetOptionChains(ctx, "SPX", "2024-01-19")
+	assert.NoError(t, err)
+	assert.Equal(t, -0.1, chains[0].Puts[0].Delta)
+	health := market.Health()
+	assert.False(t, health[0].Healthy)
+	assert.Equal(t, int64(1), health[0].Failures)
+	assert.Contains(t, health[0].LastError, "502")
+	assert.False(t, health[1].Healthy)
+	assert.Equal(t, int64(1), health[1].Stales)
+	assert.Equal(t, now.Add(time.Minute), health[1].CoolingUntil)
+	assert.True(t, health[2].Healthy)
+	assert.Equal(t, now, health[2].LastSuccessAt)
+
+	// the cooling source is tried last, the freshest stale answer wins when all are stale
+	fresh.at = now.Add(-5 * time.Minute)
+	chains, err = market.GetOptionChains(ctx, "SPX", "2024-01-19")
+	assert.NoError(t, err)
+	assert.Equal(t, -0.1, chains[0].Puts[0].Delta)
+	assert.Equal(t, 2, primary.calls)
+	assert.Equal(t, now.Add(time.Minute), market.Health()[0].CoolingUntil)
+
+	// all failed
+	stale.err, fresh.err = primary.err, primary.err
+	_, err = market.GetOptionChains(ctx, "SPX", "2024-01-19")
+	assert.Equal(t, ErrorKindUpstream, KindOf(err))
+}
+
+func TestCompositeMarket_MergeGreeks(t *testing.T) {
+	now := time.Date(2024, 1, 19, 15, 0, 0, 0, time.UTC)
+	quotes := &fixedMarket{at: now, delta: 0}
+	greeks := &fixedMarket{at: now.Add(-time.Second), delta: -0.12}
+	market := NewCompositeMarket(
+		[]Source{{"quotes", quotes}, {"greeks", greeks}},
+		CompositeOptions{MaxQuoteAge: time.Minute, MaxGreeksAge: time.Minute, GreeksSource: "greeks"},
+	)
+	market.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	chains, err := market.GetOptionChains(ctx, "SPX", "2024-01-19")
+	assert.NoError(t, err)
+	put := chains[0].Puts[0]
+	assert.Equal(t, -0.12, put.Delta)
+	assert.Equal(t, now.Add(-time.Second).UnixMilli(), put.GreeksUpdatedAt)
+	assert.Equal(t, now.UnixMilli(), put.QuoteAt)
+
+	// the served greeks are kept when the greeks source fails
+	greeks.err = xerrors.New("timeout")
+	chains, err = market.GetOptionChains(ctx, "SPX", "2024-01-19")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, chains[0].Puts[0].Delta)
+	assert.False(t, market.Health()[1].Healthy)
+}
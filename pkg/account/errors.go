@@ -0,0 +1,184 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/go-resty/resty/v2"
+	"golang.org/x/xerrors"
+)
+
+type ErrorKind int
+
+const (
+	ErrorKindUnknown ErrorKind = iota
+	// the broker rejected the call because of too many requests
+	ErrorKindRateLimited
+	// the api key or token is invalid or expired
+	ErrorKindUnauthorized
+	// the symbol, order or account doesn't exist
+	ErrorKindNotFound
+	// the broker rejected the arguments of the call
+	ErrorKindBadRequest
+	// the broker is unreachable or failed with 5xx
+	ErrorKindUpstream
+	// the broker answered with a payload we can't parse
+	ErrorKindMalformed
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorKindRateLimited:
+		return "rate limited"
+	case ErrorKindUnauthorized:
+		return "unauthorized"
+	case ErrorKindNotFound:
+		return "not found"
+	case ErrorKindBadRequest:
+		return "bad request"
+	case ErrorKindUpstream:
+		return "upstream"
+	case ErrorKindMalformed:
+		return "malformed payload"
+	default:
+		return "unknown"
+	}
+}
+
+// Error is a classified failure of a broker api call
+type Error struct {
+	Kind ErrorKind
+	// what we were doing, e.g. "get option chains"
+	Op         string
+	StatusCode int
+	Body       string
+	Err        error
+}
+
+func (e *Error) Error() string {
+	if e.StatusCode > 0 && e.Err == nil {
+		return fmt.Sprintf("failed to %s, status: %d, body: %s", e.Op, e.StatusCode, e.Body)
+	}
+	if e.StatusCode > 0 {
+		return fmt.Sprintf("failed to %s, status: %d, %s: %v", e.Op, e.StatusCode, e.Kind, e.Err)
+	}
+	return fmt.Sprintf("failed to %s, %s: %v", e.Op, e.Kind, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Retryable reports whether the same call may succeed later
+func (e *Error) Retryable() bool {
+	return e.Kind == ErrorKindRateLimited || e.Kind == ErrorKindUpstream
+}
+
+// KindOf returns the kind of the first *Error in err's chain
+func KindOf(err error) ErrorKind {
+	var e *Error
+	if xerrors.As(err, &e) {
+		return e.Kind
+	}
+	return ErrorKindUnknown
+}
+
+func kindOfStatus(statusCode int) ErrorKind {
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		return ErrorKindRateLimited
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return ErrorKindUnauthorized
+	case statusCode == http.StatusNotFound:
+		return ErrorKindNotFound
+	case statusCode >= 500:
+		return ErrorKindUpstream
+	case statusCode >= 400:
+		return ErrorKindBadRequest
+	default:
+		return ErrorKindUnknown
+	}
+}
+
+// CheckResponse classifies the result of a resty call, returns nil if the call succeeded
+func CheckResponse(op string, resp *resty.Response, err error) error {
+	if err != nil {
+		if resp != nil && resp.StatusCode() > 0 {
+			// resty failed to parse the body of a response
+			return &Error{
+				Kind: ErrorKindMalformed, Op: op, StatusCode: resp.StatusCode(), Err: err,
+			}
+		}
+		if xerrors.Is(err, context.Canceled) || xerrors.Is(err, context.DeadlineExceeded) {
+			return xerrors.Errorf("failed to %s: %w", op, err)
+		}
+		return &Error{Kind: ErrorKindUpstream, Op: op, Err: err}
+	}
+	if resp.IsError() {
+		return &Error{
+			Kind:       kindOfStatus(resp.StatusCode()),
+			Op:         op,
+			StatusCode: resp.StatusCode(),
+			Body:       resp.String(),
+		}
+	}
+	return nil
+}
+
+// MalformedError reports a payload which was parsed but holds invalid values
+func MalformedError(op string, err error) error {
+	return &Error{Kind: ErrorKindMalformed, Op: op, Err: err}
+}
+
+// SetRetry retries rate limited, 5xx and transport failures with jittered exponential backoff,
+// only idempotent GET requests are retried, a rate limited order may still have been placed
+// by a broker which limits after processing
+func SetRetry(client *resty.Client, logger slog.Logger) {
+	client.
+		SetRetryCount(3).
+		SetRetryWaitTime(500 * time.Millisecond).
+		SetRetryMaxWaitTime(10 * time.Second).
+		SetRetryAfter(retryAfter).
+		AddRetryCondition(
+			func(resp *resty.Response, err error) bool {
+				if resp == nil || resp.Request == nil || resp.Request.Method != resty.MethodGet {
+					return false
+				}
+				if resp.StatusCode() == http.StatusTooManyRequests {
+					return true
+				}
+				if err != nil {
+					// a response which failed to parse won't parse better next time
+					return resp.StatusCode() == 0
+				}
+				return resp.StatusCode() >= 500
+			},
+		).
+		AddRetryHook(
+			func(resp *resty.Response, err error) {
+				fields := []any{slog.Error(err)}
+				if resp != nil && resp.Request != nil {
+					fields = append(
+						fields, slog.F("url", resp.Request.URL), slog.F("status", resp.StatusCode()),
+					)
+				}
+				logger.Warn(context.Background(), "retry broker request", fields...)
+			},
+		)
+}
+
+// retryAfter honours the Retry-After header in seconds, zero falls back to the jittered backoff
+func retryAfter(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+	if resp == nil {
+		return 0, nil
+	}
+	seconds, err := strconv.Atoi(resp.Header().Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0, nil
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
@@ -0,0 +1,100 @@
+package account
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFlakyServer answers with the statuses in order, then 200 with a json body
+func newFlakyServer(statuses ...int) (*httptest.Server, *atomic.Int32) {
+	calls := &atomic.Int32{}
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				n := int(calls.Add(1))
+				w.Header().Set("Content-Type", "application/json")
+				if n <= len(statuses) {
+					w.WriteHeader(statuses[n-1])
+					w.Write([]byte(`{"fault":"oops"}`))
+					return
+				}
+				w.Write([]byte(`{"ok":true}`))
+			},
+		),
+	)
+	return server, calls
+}
+
+func newRetryClient(baseURL string) *resty.Client {
+	client := resty.New().SetBaseURL(baseURL)
+	SetRetry(client, util.DefaultLogger)
+	// keep the test fast
+	client.SetRetryWaitTime(time.Millisecond).SetRetryMaxWaitTime(5 * time.Millisecond)
+	return client
+}
+
+func TestSetRetry(t *testing.T) {
+	ctx := context.Background()
+
+	// 5xx and 429 are retried for GET
+	server, calls := newFlakyServer(http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	defer server.Close()
+	resp, err := newRetryClient(server.URL).R().SetContext(ctx).Get("/")
+	assert.NoError(t, CheckResponse("get", resp, err))
+	assert.Equal(t, int32(3), calls.Load())
+
+	// 5xx isn't retried for POST, which may not be idempotent
+	server, calls = newFlakyServer(http.StatusBadGateway)
+	defer server.Close()
+	resp, err = newRetryClient(server.URL).R().SetContext(ctx).Post("/")
+	err = CheckResponse("post", resp, err)
+	assert.Equal(t, ErrorKindUpstream, KindOf(err))
+	assert.Equal(t, int32(1), calls.Load())
+
+	// neither is 429, an order may have been placed
+	server, calls = newFlakyServer(http.StatusTooManyRequests)
+	defer server.Close()
+	resp, err = newRetryClient(server.URL).R().SetContext(ctx).Post("/")
+	err = CheckResponse("post", resp, err)
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+
+	// 401 is never retried
+	server, calls = newFlakyServer(http.StatusUnauthorized)
+	defer server.Close()
+	resp, err = newRetryClient(server.URL).R().SetContext(ctx).Get("/")
+	err = CheckResponse("get", resp, err)
+	assert.Equal(t, ErrorKindUnauthorized, KindOf(err))
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Contains(t, err.Error(), "failed to get, status: 401")
+}
+
+func TestCheckResponse_Malformed(t *testing.T) {
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"ok":`))
+			},
+		),
+	)
+	defer server.Close()
+	body := &struct {
+		OK bool `json:"ok"`
+	}{}
+	resp, err := resty.New().R().SetResult(body).Get(server.URL)
+	assert.Equal(t, ErrorKindMalformed, KindOf(CheckResponse("get", resp, err)))
+
+	// transport failures are upstream errors
+	server.Close()
+	resp, err = resty.New().R().Get(server.URL)
+	assert.Equal(t, ErrorKindUpstream, KindOf(CheckResponse("get", resp, err)))
+}
@@ -5,7 +5,8 @@ import (
 
 	"cdr.dev/slog"
 	"github.com/go-resty/resty/v2"
-	"golang.org/x/xerrors"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
 )
 
 type IBKR struct {
@@ -21,21 +22,14 @@ func NewIBKR(host string) *IBKR {
 		logger: util.DefaultLogger.With(slog.F("broker", "ibkr")),
 	}
 	ibkr.client.SetBaseURL(ibkr.host)
+	account.SetRetry(ibkr.client, ibkr.logger)
 	return ibkr
 }
 
 // Login Please refer to https://github.com/ppaanngggg/ib-cp-server
 func (i *IBKR) Login(ctx context.Context) error {
 	resp, err := i.client.R().SetContext(ctx).Post("/v1/api/login")
-	if err != nil {
-		return xerrors.New(err.Error())
-	}
-	if resp.IsError() {
-		return xerrors.Errorf(
-			"failed to login, status: %s, body: %s", resp.Status(), resp.String(),
-		)
-	}
-	return nil
+	return account.CheckResponse("login", resp, err)
 }
 
 // TODO: implement the rest of the market interface
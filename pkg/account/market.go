@@ -18,8 +18,15 @@ type Market interface {
 	GetOptionChains(
 		ctx context.Context, underlying string, expiration string,
 	) ([]*datasourcev1.Chain, error)
+	// GetQuotes returns the latest quotes of the given stock, etf or index symbols, e.g. ["SPX", "SPY"]
+	GetQuotes(ctx context.Context, symbols []string) ([]*datasourcev1.Quote, error)
 	// GetTodayTradePeriod returns the trading period for today
 	GetTodayTradePeriod(ctx context.Context) (*datasourcev1.TradePeriod, error)
+	// GetTradeCalendar returns the trading period of every day between from and to, both inclusive
+	// in the format "YYYY-MM-DD", including closed days, early closes and extended hours
+	GetTradeCalendar(
+		ctx context.Context, from string, to string,
+	) ([]*datasourcev1.TradePeriod, error)
 }
 
 func SortByStrikePrice(c *datasourcev1.Chain) {
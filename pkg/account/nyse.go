@@ -0,0 +1,147 @@
+package account
+
+import (
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+)
+
+// NYSECalendar returns the trade periods between from and to (yyyy-mm-dd, inclusive) by the NYSE
+// holiday rules, it's a fallback when the broker is unreachable and can't know unscheduled closures
+func NYSECalendar(from string, to string) ([]*datasourcev1.TradePeriod, error) {
+	start, err := time.ParseInLocation("2006-01-02", from, util.TZNewYork)
+	if err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	end, err := time.ParseInLocation("2006-01-02", to, util.TZNewYork)
+	if err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	var periods []*datasourcev1.TradePeriod
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		periods = append(periods, NYSETradePeriod(day))
+	}
+	return periods, nil
+}
+
+// NYSETradePeriod returns the trade period of the day in New York by the NYSE holiday rules
+func NYSETradePeriod(day time.Time) *datasourcev1.TradePeriod {
+	day = day.In(util.TZNewYork)
+	date := day.Format("2006-01-02")
+	at := func(hour, minute int) int64 {
+		return time.Date(
+			day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, util.TZNewYork,
+		).UnixMilli()
+	}
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return &datasourcev1.TradePeriod{Date: date, Description: "Market is closed on weekends"}
+	}
+	if holiday, ok := nyseHolidays(day.Year())[date]; ok {
+		return &datasourcev1.TradePeriod{
+			Date: date, Description: "Market is closed for " + holiday,
+		}
+	}
+	period := &datasourcev1.TradePeriod{
+		Date:              date,
+		IsOpen:            true,
+		PremarketOpenAt:   at(4, 0),
+		OpenAt:            at(9, 30),
+		CloseAt:           at(16, 0),
+		PostmarketCloseAt: at(20, 0),
+		Description:       "Market is open",
+	}
+	if isNYSEEarlyClose(day) {
+		period.CloseAt = at(13, 0)
+		period.PostmarketCloseAt = at(17, 0)
+		period.IsEarlyClose = true
+		period.Description = "Market closes early at 13:00"
+	}
+	return period
+}
+
+// nyseHolidays returns the observed full day holidays of the year keyed by yyyy-mm-dd
+func nyseHolidays(year int) map[string]string {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, util.TZNewYork)
+	}
+	// the n-th weekday of the month, a negative n counts from the end of the month
+	nthWeekday := func(month time.Month, weekday time.Weekday, n int) time.Time {
+		if n > 0 {
+			first := date(month, 1)
+			offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+			return first.AddDate(0, 0, offset+(n-1)*7)
+		}
+		last := date(month+1, 1).AddDate(0, 0, -1)
+		offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+		return last.AddDate(0, 0, -offset+(n+1)*7)
+	}
+	// a holiday on Saturday is observed on Friday, on Sunday is observed on Monday
+	observed := func(t time.Time) time.Time {
+		switch t.Weekday() {
+		case time.Saturday:
+			return t.AddDate(0, 0, -1)
+		case time.Sunday:
+			return t.AddDate(0, 0, 1)
+		default:
+			return t
+		}
+	}
+
+	holidays := make(map[string]string)
+	add := func(t time.Time, name string) {
+		holidays[t.Format("2006-01-02")] = name
+	}
+	// New Year's Day on Saturday is not observed on the Friday before, which closes the prior year
+	if newYear := date(time.January, 1); newYear.Weekday() != time.Saturday {
+		add(observed(newYear), "New Year's Day")
+	}
+	add(nthWeekday(time.January, time.Monday, 3), "Martin Luther King, Jr. Day")
+	add(nthWeekday(time.February, time.Monday, 3), "Washington's Birthday")
+	add(easter(year).AddDate(0, 0, -2), "Good Friday")
+	add(nthWeekday(time.May, time.Monday, -1), "Memorial Day")
+	if year >= 2022 {
+		add(observed(date(time.June, 19)), "Juneteenth National Independence Day")
+	}
+	add(observed(date(time.July, 4)), "Independence Day")
+	add(nthWeekday(time.September, time.Monday, 1), "Labor Day")
+	add(nthWeekday(time.November, time.Thursday, 4), "Thanksgiving Day")
+	add(observed(date(time.December, 25)), "Christmas Day")
+	return holidays
+}
+
+// isNYSEEarlyClose reports the 13:00 closes, the day before Independence Day,
+// the day after Thanksgiving and Christmas Eve, unless they are holidays themselves
+func isNYSEEarlyClose(day time.Time) bool {
+	weekday := day.Weekday()
+	switch {
+	case day.Month() == time.July && day.Day() == 3:
+		return weekday >= time.Monday && weekday <= time.Thursday
+	case day.Month() == time.December && day.Day() == 24:
+		return weekday >= time.Monday && weekday <= time.Thursday
+	case day.Month() == time.November && weekday == time.Friday:
+		thanksgiving := day.AddDate(0, 0, -1)
+		return thanksgiving.Day() > 21 && thanksgiving.Day() <= 28
+	}
+	return false
+}
+
+// easter returns the Easter Sunday of the Gregorian calendar by the anonymous algorithm
+func easter(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, util.TZNewYork)
+}
@@ -0,0 +1,52 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNYSECalendar(t *testing.T) {
+	periods, err := NYSECalendar("2024-01-01", "2024-12-31")
+	assert.NoError(t, err)
+	assert.Len(t, periods, 366)
+
+	closed := make(map[string]bool)
+	early := make(map[string]bool)
+	opened := 0
+	for _, period := range periods {
+		if !period.IsOpen {
+			closed[period.Date] = true
+			continue
+		}
+		opened++
+		if period.IsEarlyClose {
+			early[period.Date] = true
+		}
+	}
+	// NYSE had 252 trading days in 2024
+	assert.Equal(t, 252, opened)
+	for _, holiday := range []string{
+		"2024-01-01", "2024-01-15", "2024-02-19", "2024-03-29", "2024-05-27",
+		"2024-06-19", "2024-07-04", "2024-09-02", "2024-11-28", "2024-12-25",
+	} {
+		assert.True(t, closed[holiday], holiday)
+	}
+	assert.Equal(t, map[string]bool{"2024-07-03": true, "2024-11-29": true, "2024-12-24": true}, early)
+
+	// the early close happens at 13:00 New York time
+	period := NYSETradePeriod(time.Date(2024, 11, 29, 0, 0, 0, 0, util.TZNewYork))
+	assert.Equal(t, 13, time.UnixMilli(period.CloseAt).In(util.TZNewYork).Hour())
+}
+
+func TestNYSEHolidays_Observed(t *testing.T) {
+	// Juneteenth and Independence Day on weekends of 2021 and 2026
+	assert.Contains(t, nyseHolidays(2021), "2021-07-05")
+	assert.NotContains(t, nyseHolidays(2021), "2021-06-18")
+	assert.Contains(t, nyseHolidays(2026), "2026-07-03")
+	// New Year's Day on Saturday is not observed
+	assert.NotContains(t, nyseHolidays(2022), "2021-12-31")
+	assert.Equal(t, "2025-04-18", easter(2025).AddDate(0, 0, -2).Format("2006-01-02"))
+}
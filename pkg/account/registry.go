@@ -0,0 +1,124 @@
+package account
+
+import (
+	"context"
+	"io"
+	"slices"
+	"sync"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	accountv1 "github.com/ppaanngggg/option-bot/proto/gen/account/v1"
+	"golang.org/x/xerrors"
+)
+
+// Factory creates the market and broker of an account from its setting
+type Factory func(setting *accountv1.Setting) (Market, Broker, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = make(map[accountv1.AccountType]Factory)
+)
+
+// RegisterFactory makes a broker implementation available by its account type,
+// broker packages call it in init, e.g. import _ ".../pkg/account/tradier"
+func RegisterFactory(accountType accountv1.AccountType, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	if _, ok := factories[accountType]; ok {
+		panic("account factory registered twice, type: " + accountType.String())
+	}
+	factories[accountType] = factory
+}
+
+// Client is an opened account
+type Client struct {
+	ID      string
+	Setting *accountv1.Setting
+	Market  Market
+	Broker  Broker
+}
+
+var accounts = util.NewFileStore[*accountv1.GetResponse]("accounts")
+
+var (
+	clientsMu sync.Mutex
+	clients   = make(map[string]*Client)
+)
+
+// Open returns the client of a stored account, clients are created once and shared
+func Open(id string) (*Client, error) {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+	if client, ok := clients[id]; ok {
+		return client, nil
+	}
+	record, ok, err := accounts.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, xerrors.Errorf("account not found, id: %s", id)
+	}
+	factoriesMu.RLock()
+	factory, ok := factories[record.Setting.GetType()]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, xerrors.Errorf("unsupported account type: %s", record.Setting.GetType())
+	}
+	market, broker, err := factory(record.Setting)
+	if err != nil {
+		return nil, err
+	}
+	client := &Client{ID: id, Setting: record.Setting, Market: market, Broker: broker}
+	clients[id] = client
+	return client, nil
+}
+
+// closeAll closes every io.Closer of values once, the market and the broker are usually the same value
+func closeAll(id string, values ...any) {
+	closed := make([]io.Closer, 0, len(values))
+	for _, value := range values {
+		closer, ok := value.(io.Closer)
+		if !ok || slices.Contains(closed, closer) {
+			continue
+		}
+		closed = append(closed, closer)
+		if err := closer.Close(); err != nil {
+			util.DefaultLogger.Warn(
+				context.Background(), "failed to close account client", slog.F("id", id), slog.Error(err),
+			)
+		}
+	}
+}
+
+var (
+	closeHooksMu sync.Mutex
+	closeHooks   []func(id string)
+)
+
+// OnClose registers a hook called after the shared client of an account is dropped,
+// so that whoever keeps its market drops it too
+func OnClose(hook func(id string)) {
+	closeHooksMu.Lock()
+	defer closeHooksMu.Unlock()
+	closeHooks = append(closeHooks, hook)
+}
+
+// closeClient drops the shared client after the account is deleted, and closes its market and broker
+// which hold background resources, e.g. the quote stream of Tradier
+func closeClient(id string) {
+	clientsMu.Lock()
+	client, ok := clients[id]
+	delete(clients, id)
+	clientsMu.Unlock()
+	if ok {
+		closeAll(id, client.Market, client.Broker)
+	}
+	closeHooksMu.Lock()
+	hooks := slices.Clone(closeHooks)
+	closeHooksMu.Unlock()
+	for _, hook := range hooks {
+		hook(id)
+	}
+}
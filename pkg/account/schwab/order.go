@@ -0,0 +1,321 @@
+package schwab
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	accountv1 "github.com/ppaanngggg/option-bot/proto/gen/account/v1"
+	"golang.org/x/xerrors"
+)
+
+func init() {
+	account.RegisterFactory(
+		accountv1.AccountType_ACCOUNT_TYPE_SCHWAB,
+		func(setting *accountv1.Setting) (account.Market, account.Broker, error) {
+			s := setting.GetSchwab()
+			if s.GetAppKey() == "" || s.GetAppSecret() == "" || s.GetRefreshToken() == "" {
+				return nil, nil, xerrors.New("schwab app key, app secret and refresh token are required")
+			}
+			schwab := NewSchwab(s.AppKey, s.AppSecret, s.RefreshToken)
+			schwab.accountNumber = s.AccountNumber
+			return schwab, schwab, nil
+		},
+	)
+}
+
+var _ account.Broker = (*Schwab)(nil)
+
+// getAccountHash returns the hash of the account number, which identifies the account in trader apis
+func (s *Schwab) getAccountHash(ctx context.Context) (string, error) {
+	if s.accountNumber == "" {
+		return "", xerrors.New("schwab account number is not set")
+	}
+	s.mu.Lock()
+	hash := s.accountHash
+	s.mu.Unlock()
+	if hash != "" {
+		return hash, nil
+	}
+	req, err := s.request(ctx)
+	if err != nil {
+		return "", err
+	}
+	var body []struct {
+		AccountNumber string `json:"accountNumber"`
+		HashValue     string `json:"hashValue"`
+	}
+	resp, err := req.SetResult(&body).Get("/trader/v1/accounts/accountNumbers")
+	if err := account.CheckResponse("get account numbers", resp, err); err != nil {
+		return "", err
+	}
+	for _, a := range body {
+		if a.AccountNumber == s.accountNumber {
+			s.mu.Lock()
+			s.accountHash = a.HashValue
+			s.mu.Unlock()
+			return a.HashValue, nil
+		}
+	}
+	return "", xerrors.Errorf("schwab account %s is not authorized", s.accountNumber)
+}
+
+type orderLeg struct {
+	LegID       int64  `json:"legId,omitempty"`
+	Instruction string `json:"instruction"` // e.g. BUY_TO_OPEN
+	Quantity    int32  `json:"quantity"`
+	Instrument  struct {
+		Symbol    string `json:"symbol"`
+		AssetType string `json:"assetType"`
+	} `json:"instrument"`
+}
+
+// PlaceOrder refer to https://developer.schwab.com/products/trader-api--individual/details/specifications/Retail%20Trader%20API%20Production
+func (s *Schwab) PlaceOrder(ctx context.Context, order *account.Order) (string, error) {
+	if len(order.Legs) == 0 {
+		return "", xerrors.New("order has no legs")
+	}
+	hash, err := s.getAccountHash(ctx)
+	if err != nil {
+		return "", err
+	}
+	body := &struct {
+		OrderType                string      `json:"orderType"`
+		Session                  string      `json:"session"`
+		Duration                 string      `json:"duration"`
+		Price                    json.Number `json:"price,omitempty"`
+		OrderStrategyType        string      `json:"orderStrategyType"`
+		ComplexOrderStrategyType string      `json:"complexOrderStrategyType,omitempty"`
+		OrderLegCollection       []orderLeg  `json:"orderLegCollection"`
+	}{
+		Session:           "NORMAL",
+		Duration:          "DAY",
+		OrderStrategyType: "SINGLE",
+	}
+	for _, leg := range order.Legs {
+		l := orderLeg{Instruction: strings.ToUpper(leg.Side.String()), Quantity: leg.Quantity}
+		l.Instrument.Symbol = toOptionSymbol(leg.Symbol)
+		l.Instrument.AssetType = "OPTION"
+		body.OrderLegCollection = append(body.OrderLegCollection, l)
+	}
+	switch {
+	case order.IsMarket:
+		body.OrderType = "MARKET"
+	case len(order.Legs) == 1:
+		body.OrderType = "LIMIT"
+		body.Price = formatPrice(math.Abs(order.Price))
+	case order.Price > 0:
+		body.OrderType = "NET_DEBIT"
+		body.Price = formatPrice(order.Price)
+	case order.Price < 0:
+		body.OrderType = "NET_CREDIT"
+		body.Price = formatPrice(-order.Price)
+	default:
+		body.OrderType = "NET_ZERO"
+	}
+	if len(order.Legs) > 1 {
+		body.ComplexOrderStrategyType = "CUSTOM"
+	}
+	req, err := s.request(ctx)
+	if err != nil {
+		return "", err
+	}
+	resp, err := req.
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		Post("/trader/v1/accounts/" + hash + "/orders")
+	if err := account.CheckResponse("place order", resp, err); err != nil {
+		return "", err
+	}
+	// the order id is only in the location, e.g. .../accounts/{hash}/orders/123
+	location := resp.Header().Get("Location")
+	if location == "" {
+		return "", account.MalformedError("place order", xerrors.New("no order location"))
+	}
+	return path.Base(location), nil
+}
+
+// GetOrder refer to https://developer.schwab.com/products/trader-api--individual/details/specifications/Retail%20Trader%20API%20Production
+func (s *Schwab) GetOrder(ctx context.Context, id string) (*account.OrderResult, error) {
+	hash, err := s.getAccountHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.request(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body := &struct {
+		Status                  string     `json:"status"`
+		FilledQuantity          float64    `json:"filledQuantity"`
+		OrderLegCollection      []orderLeg `json:"orderLegCollection"`
+		OrderActivityCollection []struct {
+			ExecutionLegs []struct {
+				LegID    int64   `json:"legId"`
+				Price    float64 `json:"price"`
+				Quantity float64 `json:"quantity"`
+			} `json:"executionLegs"`
+		} `json:"orderActivityCollection"`
+	}{}
+	resp, err := req.SetResult(body).Get("/trader/v1/accounts/" + hash + "/orders/" + id)
+	if err := account.CheckResponse("get order", resp, err); err != nil {
+		return nil, err
+	}
+	result := &account.OrderResult{
+		ID:                  id,
+		LegFillPrices:       make(map[string]float64, len(body.OrderLegCollection)),
+		LegFilledQuantities: make(map[string]float64, len(body.OrderLegCollection)),
+	}
+	switch body.Status {
+	case "FILLED":
+		result.Status = account.OrderStatusFilled
+	case "CANCELED", "REPLACED":
+		result.Status = account.OrderStatusCanceled
+	case "EXPIRED":
+		result.Status = account.OrderStatusExpired
+	case "REJECTED":
+		result.Status = account.OrderStatusRejected
+	default:
+		result.Status = account.OrderStatusPending
+		if body.FilledQuantity > 0 {
+			result.Status = account.OrderStatusPartiallyFilled
+		}
+	}
+	// average the executions of every leg
+	amounts := make(map[int64]float64)
+	quantities := make(map[int64]float64)
+	for _, activity := range body.OrderActivityCollection {
+		for _, execution := range activity.ExecutionLegs {
+			amounts[execution.LegID] += execution.Price * execution.Quantity
+			quantities[execution.LegID] += execution.Quantity
+		}
+	}
+	// the net price per share of one unit, the unit is the gcd of the leg quantities
+	unit := int32(0)
+	for _, leg := range body.OrderLegCollection {
+		unit = gcd(unit, leg.Quantity)
+	}
+	for _, leg := range body.OrderLegCollection {
+		if quantities[leg.LegID] == 0 {
+			continue
+		}
+		price := amounts[leg.LegID] / quantities[leg.LegID]
+		result.LegFillPrices[fromOptionSymbol(leg.Instrument.Symbol)] = price
+		result.LegFilledQuantities[fromOptionSymbol(leg.Instrument.Symbol)] = quantities[leg.LegID]
+		sign := 1.0
+		if strings.HasPrefix(leg.Instruction, "SELL") {
+			sign = -1
+		}
+		result.FillPrice += sign * price * float64(leg.Quantity) / float64(unit)
+	}
+	return result, nil
+}
+
+func gcd(a, b int32) int32 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// CancelOrder refer to https://developer.schwab.com/products/trader-api--individual/details/specifications/Retail%20Trader%20API%20Production
+func (s *Schwab) CancelOrder(ctx context.Context, id string) error {
+	hash, err := s.getAccountHash(ctx)
+	if err != nil {
+		return err
+	}
+	req, err := s.request(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := req.Delete("/trader/v1/accounts/" + hash + "/orders/" + id)
+	return account.CheckResponse("cancel order", resp, err)
+}
+
+type securitiesAccount struct {
+	SecuritiesAccount struct {
+		Type      string `json:"type"` // MARGIN or CASH
+		Positions []struct {
+			LongQuantity  float64 `json:"longQuantity"`
+			ShortQuantity float64 `json:"shortQuantity"`
+			Instrument    struct {
+				Symbol    string `json:"symbol"`
+				AssetType string `json:"assetType"`
+			} `json:"instrument"`
+		} `json:"positions"`
+		CurrentBalances struct {
+			LiquidationValue              float64 `json:"liquidationValue"`
+			CashBalance                   float64 `json:"cashBalance"`
+			CashAvailableForTrading       float64 `json:"cashAvailableForTrading"`
+			BuyingPowerNonMarginableTrade float64 `json:"buyingPowerNonMarginableTrade"`
+		} `json:"currentBalances"`
+	} `json:"securitiesAccount"`
+}
+
+func (s *Schwab) getAccount(ctx context.Context, op string) (*securitiesAccount, error) {
+	hash, err := s.getAccountHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.request(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body := &securitiesAccount{}
+	resp, err := req.
+		SetQueryParam("fields", "positions").
+		SetResult(body).
+		Get("/trader/v1/accounts/" + hash)
+	if err := account.CheckResponse(op, resp, err); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// GetBalances refer to https://developer.schwab.com/products/trader-api--individual/details/specifications/Retail%20Trader%20API%20Production
+func (s *Schwab) GetBalances(ctx context.Context) (*account.Balances, error) {
+	body, err := s.getAccount(ctx, "get balances")
+	if err != nil {
+		return nil, err
+	}
+	b := body.SecuritiesAccount.CurrentBalances
+	balances := &account.Balances{
+		TotalEquity:   b.LiquidationValue,
+		CashAvailable: b.CashBalance,
+	}
+	if body.SecuritiesAccount.Type == "MARGIN" {
+		// options aren't marginable, so the non marginable buying power is the option buying power
+		balances.OptionBuyingPower = b.BuyingPowerNonMarginableTrade
+	} else {
+		balances.CashAvailable = b.CashAvailableForTrading
+		balances.OptionBuyingPower = b.CashAvailableForTrading
+	}
+	return balances, nil
+}
+
+// GetPositions refer to https://developer.schwab.com/products/trader-api--individual/details/specifications/Retail%20Trader%20API%20Production
+func (s *Schwab) GetPositions(ctx context.Context) ([]account.Holding, error) {
+	body, err := s.getAccount(ctx, "get positions")
+	if err != nil {
+		return nil, err
+	}
+	holdings := make([]account.Holding, 0, len(body.SecuritiesAccount.Positions))
+	for _, p := range body.SecuritiesAccount.Positions {
+		symbol := p.Instrument.Symbol
+		if p.Instrument.AssetType == "OPTION" {
+			symbol = fromOptionSymbol(symbol)
+		}
+		holdings = append(
+			holdings, account.Holding{Symbol: symbol, Quantity: p.LongQuantity - p.ShortQuantity},
+		)
+	}
+	return holdings, nil
+}
+
+func formatPrice(price float64) json.Number {
+	return json.Number(strconv.FormatFloat(price, 'f', 2, 64))
+}
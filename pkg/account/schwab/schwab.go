@@ -0,0 +1,555 @@
+package schwab
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/go-resty/resty/v2"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+)
+
+// Token is an oauth token of an app
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	// unix timestamp in ms
+	ExpiresAt int64 `json:"expires_at"`
+	// the configured refresh token this token is refreshed from
+	Configured string `json:"configured"`
+}
+
+// tokens persists the latest token of every authorization keyed by tokenKey,
+// so a restart doesn't use a stale refresh token
+var tokens = util.NewFileStore[*Token]("schwab_tokens")
+
+// tokenLeeway refreshes the access token a bit before it expires
+const tokenLeeway = time.Minute
+
+func NewSchwab(appKey string, appSecret string, refreshToken string) *Schwab {
+	schwab := &Schwab{
+		appKey:       appKey,
+		appSecret:    appSecret,
+		refreshToken: refreshToken,
+		client:       resty.New(),
+		logger:       util.DefaultLogger.With(slog.F("broker", "schwab")),
+	}
+	schwab.client.SetBaseURL("https://api.schwabapi.com")
+	account.SetRetry(schwab.client, schwab.logger)
+	return schwab
+}
+
+var _ account.Market = (*Schwab)(nil)
+
+type Schwab struct {
+	appKey        string
+	appSecret     string
+	refreshToken  string
+	accountNumber string
+	client        *resty.Client
+	logger        slog.Logger
+
+	mu          sync.Mutex
+	token       *Token
+	accountHash string
+}
+
+// tokenKey identifies the authorization of the account, accounts authorized under one app
+// have their own refresh tokens
+func (s *Schwab) tokenKey() string {
+	if s.accountNumber == "" {
+		return s.appKey
+	}
+	return s.appKey + "_" + s.accountNumber
+}
+
+// accessToken returns a valid access token, refreshing it by the refresh token if it's expiring,
+// refer to https://developer.schwab.com/products/trader-api--individual/details/documentation/Retail%20Trader%20API%20Production
+func (s *Schwab) accessToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == nil {
+		stored, ok, err := tokens.Get(s.tokenKey())
+		if err != nil {
+			return "", err
+		}
+		// a refresh token configured after the token was stored is a new authorization,
+		// tokens stored before the configured one was recorded are kept
+		if ok && (stored.Configured == "" || stored.Configured == s.refreshToken) {
+			s.token = stored
+		} else {
+			s.token = &Token{RefreshToken: s.refreshToken}
+		}
+	}
+	if s.token.AccessToken != "" && time.Now().Add(tokenLeeway).UnixMilli() < s.token.ExpiresAt {
+		return s.token.AccessToken, nil
+	}
+	body := &struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		ExpiresIn    int64  `json:"expires_in"` // seconds
+	}{}
+	resp, err := s.client.R().
+		SetContext(ctx).
+		SetBasicAuth(s.appKey, s.appSecret).
+		SetFormData(
+			map[string]string{
+				"grant_type":    "refresh_token",
+				"refresh_token": s.token.RefreshToken,
+			},
+		).
+		SetResult(body).
+		Post("/v1/oauth/token")
+	if err := account.CheckResponse("refresh token", resp, err); err != nil {
+		return "", err
+	}
+	if body.AccessToken == "" {
+		return "", account.MalformedError("refresh token", xerrors.New("no access token"))
+	}
+	token := &Token{
+		AccessToken:  body.AccessToken,
+		RefreshToken: body.RefreshToken,
+		ExpiresAt:    time.Now().Add(time.Duration(body.ExpiresIn) * time.Second).UnixMilli(),
+		Configured:   s.refreshToken,
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = s.token.RefreshToken
+	}
+	if err := tokens.Put(s.tokenKey(), token); err != nil {
+		return "", err
+	}
+	s.token = token
+	s.logger.Info(ctx, "access token refreshed")
+	return token.AccessToken, nil
+}
+
+// request returns a request authorized by the access token
+func (s *Schwab) request(ctx context.Context) (*resty.Request, error) {
+	token, err := s.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.R().
+		SetContext(ctx).
+		SetAuthToken(token).
+		SetHeader("Accept", "application/json"), nil
+}
+
+// indexSymbols are quoted with a $ prefix by schwab, e.g. $SPX
+var indexSymbols = map[string]bool{
+	"SPX": true,
+	"XSP": true,
+	"NDX": true,
+	"RUT": true,
+	"DJX": true,
+	"VIX": true,
+	"OEX": true,
+	"XEO": true,
+}
+
+// toMarketSymbol converts a symbol to the one of schwab's market data, e.g. SPX to $SPX
+func toMarketSymbol(symbol string) string {
+	if indexSymbols[strings.ToUpper(symbol)] {
+		return "$" + strings.ToUpper(symbol)
+	}
+	return symbol
+}
+
+func fromMarketSymbol(symbol string) string {
+	return strings.TrimPrefix(symbol, "$")
+}
+
+// occSuffixLength is the length of the expiration, type and strike of an OCC option symbol
+const occSuffixLength = 15
+
+// toOptionSymbol pads the root of an option symbol to 6 characters as schwab does,
+// e.g. SPXW240119P04700000 to "SPXW  240119P04700000", other symbols are kept
+func toOptionSymbol(symbol string) string {
+	if len(symbol) <= occSuffixLength || strings.Contains(symbol, " ") {
+		return symbol
+	}
+	root, suffix := symbol[:len(symbol)-occSuffixLength], symbol[len(symbol)-occSuffixLength:]
+	if len(root) < 6 {
+		root += strings.Repeat(" ", 6-len(root))
+	}
+	return root + suffix
+}
+
+// fromOptionSymbol removes the padding of an option symbol of schwab
+func fromOptionSymbol(symbol string) string {
+	return strings.ReplaceAll(symbol, " ", "")
+}
+
+// noValue is returned by schwab for greeks it can't compute
+const noValue = -999
+
+// greek returns 0 for noValue
+func greek(v float64) float64 {
+	if v == noValue {
+		return 0
+	}
+	return v
+}
+
+// Search refer to https://developer.schwab.com/products/trader-api--individual/details/specifications/Market%20Data%20Production
+func (s *Schwab) Search(ctx context.Context, query string) ([]*datasourcev1.Symbol, error) {
+	req, err := s.request(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body := &struct {
+		Instruments []struct {
+			Symbol      string `json:"symbol"`
+			Description string `json:"description"`
+			Exchange    string `json:"exchange"`
+			AssetType   string `json:"assetType"`
+		} `json:"instruments"`
+	}{}
+	resp, err := req.
+		SetQueryParams(
+			map[string]string{
+				"symbol":     strings.ToUpper(query) + ".*",
+				"projection": "symbol-regex",
+			},
+		).
+		SetResult(body).
+		Get("/marketdata/v1/instruments")
+	if err := account.CheckResponse("search symbols", resp, err); err != nil {
+		return nil, err
+	}
+	symbols := make([]*datasourcev1.Symbol, 0, len(body.Instruments))
+	for _, instrument := range body.Instruments {
+		symbol := &datasourcev1.Symbol{
+			Symbol:      fromMarketSymbol(instrument.Symbol),
+			Description: instrument.Description,
+		}
+		switch instrument.AssetType {
+		case "EQUITY":
+			symbol.Type = datasourcev1.SymbolType_SYMBOL_TYPE_STOCK
+		case "OPTION":
+			symbol.Type = datasourcev1.SymbolType_SYMBOL_TYPE_OPTION
+			symbol.Symbol = fromOptionSymbol(symbol.Symbol)
+		case "INDEX":
+			symbol.Type = datasourcev1.SymbolType_SYMBOL_TYPE_INDEX
+		case "ETF":
+			symbol.Type = datasourcev1.SymbolType_SYMBOL_TYPE_ETF
+		}
+		symbols = append(symbols, symbol)
+	}
+	return symbols, nil
+}
+
+// GetQuotes refer to https://developer.schwab.com/products/trader-api--individual/details/specifications/Market%20Data%20Production
+func (s *Schwab) GetQuotes(ctx context.Context, symbols []string) ([]*datasourcev1.Quote, error) {
+	req, err := s.request(ctx)
+	if err != nil {
+		return nil, err
+	}
+	marketSymbols := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		marketSymbols = append(marketSymbols, toMarketSymbol(symbol))
+	}
+	type quote struct {
+		Symbol string `json:"symbol"`
+		Quote  struct {
+			LastPrice        float64 `json:"lastPrice"`
+			BidPrice         float64 `json:"bidPrice"`
+			AskPrice         float64 `json:"askPrice"`
+			OpenPrice        float64 `json:"openPrice"`
+			HighPrice        float64 `json:"highPrice"`
+			LowPrice         float64 `json:"lowPrice"`
+			ClosePrice       float64 `json:"closePrice"` // the previous close
+			NetPercentChange float64 `json:"netPercentChange"`
+			TotalVolume      int64   `json:"totalVolume"`
+			TradeTime        int64   `json:"tradeTime"`
+		} `json:"quote"`
+	}
+	// keyed by the requested symbols
+	body := make(map[string]quote)
+	resp, err := req.
+		SetQueryParams(
+			map[string]string{
+				"symbols": strings.Join(marketSymbols, ","),
+				"fields":  "quote",
+			},
+		).
+		SetResult(&body).
+		Get("/marketdata/v1/quotes")
+	if err := account.CheckResponse("get quotes", resp, err); err != nil {
+		return nil, err
+	}
+	quotes := make([]*datasourcev1.Quote, 0, len(symbols))
+	for i, symbol := range marketSymbols {
+		q, ok := body[symbol]
+		if !ok {
+			continue
+		}
+		quotes = append(
+			quotes, &datasourcev1.Quote{
+				Symbol:           symbols[i],
+				Last:             q.Quote.LastPrice,
+				Bid:              q.Quote.BidPrice,
+				Ask:              q.Quote.AskPrice,
+				Open:             q.Quote.OpenPrice,
+				High:             q.Quote.HighPrice,
+				Low:              q.Quote.LowPrice,
+				PrevClose:        q.Quote.ClosePrice,
+				ChangePercentage: q.Quote.NetPercentChange,
+				Volume:           q.Quote.TotalVolume,
+				QuoteAt:          q.Quote.TradeTime,
+			},
+		)
+	}
+	return quotes, nil
+}
+
+// GetTodayTradePeriod refer to https://developer.schwab.com/products/trader-api--individual/details/specifications/Market%20Data%20Production
+func (s *Schwab) GetTodayTradePeriod(ctx context.Context) (*datasourcev1.TradePeriod, error) {
+	today := time.Now().In(util.TZNewYork).Format("2006-01-02")
+	periods, err := s.GetTradeCalendar(ctx, today, today)
+	if err != nil {
+		return nil, err
+	}
+	if len(periods) == 0 {
+		return nil, xerrors.Errorf("today's trade period not found, today: %s", today)
+	}
+	return periods[0], nil
+}
+
+// GetTradeCalendar queries the market hours day by day, since schwab answers one date per call
+func (s *Schwab) GetTradeCalendar(
+	ctx context.Context, from string, to string,
+) ([]*datasourcev1.TradePeriod, error) {
+	start, err := time.ParseInLocation("2006-01-02", from, util.TZNewYork)
+	if err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	end, err := time.ParseInLocation("2006-01-02", to, util.TZNewYork)
+	if err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	var periods []*datasourcev1.TradePeriod
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		period, err := s.getMarketHours(ctx, day.Format("2006-01-02"))
+		if err != nil {
+			return nil, err
+		}
+		periods = append(periods, period)
+	}
+	return periods, nil
+}
+
+func (s *Schwab) getMarketHours(ctx context.Context, date string) (*datasourcev1.TradePeriod, error) {
+	req, err := s.request(ctx)
+	if err != nil {
+		return nil, err
+	}
+	type session struct {
+		Start string `json:"start"` // RFC3339, e.g. 2024-01-19T09:30:00-05:00
+		End   string `json:"end"`
+	}
+	type hours struct {
+		Date         string `json:"date"`
+		IsOpen       bool   `json:"isOpen"`
+		SessionHours struct {
+			PreMarket     []session `json:"preMarket"`
+			RegularMarket []session `json:"regularMarket"`
+			PostMarket    []session `json:"postMarket"`
+		} `json:"sessionHours"`
+	}
+	// market -> product -> hours, e.g. equity -> EQ, the product key differs on closed days
+	body := make(map[string]map[string]hours)
+	resp, err := req.
+		SetQueryParam("date", date).
+		SetResult(&body).
+		Get("/marketdata/v1/markets/equity")
+	if err := account.CheckResponse("get trade calendar", resp, err); err != nil {
+		return nil, err
+	}
+	period := &datasourcev1.TradePeriod{Date: date}
+	for _, product := range body["equity"] {
+		if !product.IsOpen || len(product.SessionHours.RegularMarket) == 0 {
+			continue
+		}
+		parse := func(sessions []session, start bool) (int64, error) {
+			if len(sessions) == 0 {
+				return 0, nil
+			}
+			value := sessions[len(sessions)-1].End
+			if start {
+				value = sessions[0].Start
+			}
+			at, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return 0, account.MalformedError("get trade calendar", err)
+			}
+			return at.UnixMilli(), nil
+		}
+		hours := product.SessionHours
+		period.IsOpen = true
+		if period.OpenAt, err = parse(hours.RegularMarket, true); err != nil {
+			return nil, err
+		}
+		if period.CloseAt, err = parse(hours.RegularMarket, false); err != nil {
+			return nil, err
+		}
+		if period.PremarketOpenAt, err = parse(hours.PreMarket, true); err != nil {
+			return nil, err
+		}
+		if period.PostmarketCloseAt, err = parse(hours.PostMarket, false); err != nil {
+			return nil, err
+		}
+		period.IsEarlyClose = time.UnixMilli(period.CloseAt).In(util.TZNewYork).Format("15:04") < "16:00"
+		break
+	}
+	return period, nil
+}
+
+// GetOptionChains refer to https://developer.schwab.com/products/trader-api--individual/details/specifications/Market%20Data%20Production
+func (s *Schwab) GetOptionChains(
+	ctx context.Context, underlying string, expiration string,
+) ([]*datasourcev1.Chain, error) {
+	req, err := s.request(ctx)
+	if err != nil {
+		return nil, err
+	}
+	type contract struct {
+		PutCall         string  `json:"putCall"` // CALL or PUT
+		Symbol          string  `json:"symbol"`  // e.g. "SPXW  240119P04700000"
+		OptionRoot      string  `json:"optionRoot"`
+		StrikePrice     float64 `json:"strikePrice"`
+		Bid             float64 `json:"bid"`
+		BidSize         int32   `json:"bidSize"`
+		Ask             float64 `json:"ask"`
+		AskSize         int32   `json:"askSize"`
+		Volatility      float64 `json:"volatility"` // in percent
+		Delta           float64 `json:"delta"`
+		Gamma           float64 `json:"gamma"`
+		Theta           float64 `json:"theta"`
+		Vega            float64 `json:"vega"`
+		QuoteTimeInLong int64   `json:"quoteTimeInLong"`
+		Last            float64 `json:"last"`
+		LastSize        int64   `json:"lastSize"`
+		TradeTimeInLong int64   `json:"tradeTimeInLong"`
+		NetChange       float64 `json:"netChange"`
+		TotalVolume     int64   `json:"totalVolume"`
+		OpenInterest    int64   `json:"openInterest"`
+	}
+	// expiration, e.g. "2024-01-19:3" -> strike, e.g. "4700.0" -> options
+	type expDateMap map[string]map[string][]contract
+	body := &struct {
+		Symbol          string     `json:"symbol"`
+		Status          string     `json:"status"`
+		CallExpDateMap  expDateMap `json:"callExpDateMap"`
+		PutExpDateMap   expDateMap `json:"putExpDateMap"`
+		UnderlyingPrice float64    `json:"underlyingPrice"`
+	}{}
+	resp, err := req.
+		SetQueryParams(
+			map[string]string{
+				"symbol":                 toMarketSymbol(underlying),
+				"contractType":           "ALL",
+				"fromDate":               expiration,
+				"toDate":                 expiration,
+				"includeUnderlyingQuote": "false",
+			},
+		).
+		SetResult(body).
+		Get("/marketdata/v1/chains")
+	if err := account.CheckResponse("get option chains", resp, err); err != nil {
+		return nil, err
+	}
+	// group options to chains by root, e.g. SPXW and SPX
+	chains := make(map[string]*datasourcev1.Chain)
+	for _, expDateMap := range []expDateMap{body.CallExpDateMap, body.PutExpDateMap} {
+		for _, strikes := range expDateMap {
+			for _, options := range strikes {
+				for _, opt := range options {
+					chain, ok := chains[opt.OptionRoot]
+					if !ok {
+						chain = &datasourcev1.Chain{
+							RootSymbol: opt.OptionRoot,
+							Underlying: underlying,
+							Expiration: expiration,
+						}
+						chains[opt.OptionRoot] = chain
+					}
+					option := &datasourcev1.Option{
+						Symbol:          fromOptionSymbol(opt.Symbol),
+						Strike:          opt.StrikePrice,
+						Bid:             opt.Bid,
+						BidSize:         opt.BidSize,
+						Ask:             opt.Ask,
+						AskSize:         opt.AskSize,
+						QuoteAt:         opt.QuoteTimeInLong,
+						Iv:              greek(opt.Volatility) / 100,
+						Delta:           greek(opt.Delta),
+						Gamma:           greek(opt.Gamma),
+						Vega:            greek(opt.Vega),
+						Theta:           greek(opt.Theta),
+						GreeksUpdatedAt: opt.QuoteTimeInLong,
+						Last:            opt.Last,
+						LastVolume:      opt.LastSize,
+						LastAt:          opt.TradeTimeInLong,
+						Change:          opt.NetChange,
+						Volume:          opt.TotalVolume,
+						OpenInterest:    opt.OpenInterest,
+					}
+					if opt.PutCall == "CALL" {
+						chain.Calls = append(chain.Calls, option)
+					} else {
+						chain.Puts = append(chain.Puts, option)
+					}
+				}
+			}
+		}
+	}
+	rets := make([]*datasourcev1.Chain, 0, len(chains))
+	for _, chain := range chains {
+		account.SortByStrikePrice(chain)
+		rets = append(rets, chain)
+	}
+	sort.Slice(
+		rets, func(i, j int) bool {
+			return rets[i].RootSymbol < rets[j].RootSymbol
+		},
+	)
+	return rets, nil
+}
+
+// GetOptionExpirations refer to https://developer.schwab.com/products/trader-api--individual/details/specifications/Market%20Data%20Production
+func (s *Schwab) GetOptionExpirations(ctx context.Context, underlying string) ([]string, error) {
+	req, err := s.request(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body := &struct {
+		ExpirationList []struct {
+			ExpirationDate string `json:"expirationDate"` // YYYY-MM-DD
+		} `json:"expirationList"`
+	}{}
+	resp, err := req.
+		SetQueryParam("symbol", toMarketSymbol(underlying)).
+		SetResult(body).
+		Get("/marketdata/v1/expirationchain")
+	if err := account.CheckResponse("get option expirations", resp, err); err != nil {
+		return nil, err
+	}
+	// roots of the same underlying may share expirations, e.g. SPX and SPXW
+	seen := make(map[string]bool, len(body.ExpirationList))
+	expirations := make([]string, 0, len(body.ExpirationList))
+	for _, e := range body.ExpirationList {
+		if !seen[e.ExpirationDate] {
+			seen[e.ExpirationDate] = true
+			expirations = append(expirations, e.ExpirationDate)
+		}
+	}
+	sort.Strings(expirations)
+	return expirations, nil
+}
@@ -0,0 +1,180 @@
+package schwab
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/account/accounttest"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorded responses of the schwab api, trimmed to the fields we use
+const (
+	tokenResponse          = `{"expires_in":1800,"token_type":"Bearer","scope":"api","refresh_token":"refresh-2","access_token":"access-1","id_token":"id"}`
+	accountNumbersResponse = `[{"accountNumber":"12345678","hashValue":"HASH"}]`
+	quotesResponse         = `{"$SPX":{"assetMainType":"INDEX","symbol":"$SPX","quote":{"lastPrice":4780.94,"openPrice":4760.1,"highPrice":4790.8,"lowPrice":4750.3,"closePrice":4739.21,"netPercentChange":0.88,"totalVolume":0,"tradeTime":1705697999000}},"SPY":{"assetMainType":"EQUITY","symbol":"SPY","quote":{"lastPrice":482.43,"bidPrice":482.42,"askPrice":482.44,"closePrice":476.49,"totalVolume":110834465,"tradeTime":1705697999000}}}`
+	expirationsResponse    = `{"expirationList":[{"expirationDate":"2024-01-22","daysToExpiration":3,"expirationType":"W","settlementType":"P","optionRoots":"SPXW"},{"expirationDate":"2024-01-19","daysToExpiration":0,"expirationType":"S","settlementType":"A","optionRoots":"SPX"},{"expirationDate":"2024-01-19","daysToExpiration":0,"expirationType":"W","settlementType":"P","optionRoots":"SPXW"}]}`
+	chainsResponse         = `{"symbol":"$SPX","status":"SUCCESS","underlyingPrice":4780.94,"callExpDateMap":{"2024-01-19:0":{"4800.0":[{"putCall":"CALL","symbol":"SPXW  240119C04800000","optionRoot":"SPXW","strikePrice":4800.0,"bid":0.05,"bidSize":10,"ask":0.1,"askSize":20,"volatility":12.5,"delta":0.03,"gamma":0.001,"theta":-0.2,"vega":0.05,"quoteTimeInLong":1705697999000,"last":0.05,"lastSize":3,"tradeTimeInLong":1705697000000,"netChange":-0.45,"totalVolume":1520,"openInterest":8811}]}},"putExpDateMap":{"2024-01-19:0":{"4700.0":[{"putCall":"PUT","symbol":"SPXW  240119P04700000","optionRoot":"SPXW","strikePrice":4700.0,"bid":0.05,"bidSize":5,"ask":0.15,"askSize":8,"volatility":-999.0,"delta":-999.0,"gamma":-999.0,"theta":-999.0,"vega":-999.0,"quoteTimeInLong":1705697999000}],"4750.0":[{"putCall":"PUT","symbol":"SPX   240119P04750000","optionRoot":"SPX","strikePrice":4750.0,"bid":1.0,"bidSize":5,"ask":1.2,"askSize":8,"volatility":14.1,"delta":-0.1,"gamma":0.002,"theta":-0.5,"vega":0.1,"quoteTimeInLong":1705697999000}]}}}`
+	marketsResponse        = `{"equity":{"EQ":{"date":"2024-01-19","marketType":"EQUITY","product":"EQ","productName":"equity","isOpen":true,"sessionHours":{"preMarket":[{"start":"2024-01-19T07:00:00-05:00","end":"2024-01-19T09:30:00-05:00"}],"regularMarket":[{"start":"2024-01-19T09:30:00-05:00","end":"2024-01-19T16:00:00-05:00"}],"postMarket":[{"start":"2024-01-19T16:00:00-05:00","end":"2024-01-19T20:00:00-05:00"}]}}}}`
+	orderResponse          = `{"orderId":1001,"orderType":"NET_CREDIT","status":"FILLED","price":1.2,"filledQuantity":2,"orderLegCollection":[{"legId":1,"instruction":"SELL_TO_OPEN","quantity":2,"instrument":{"symbol":"SPY   240119P00460000","assetType":"OPTION"}},{"legId":2,"instruction":"BUY_TO_OPEN","quantity":2,"instrument":{"symbol":"SPY   240119P00450000","assetType":"OPTION"}}],"orderActivityCollection":[{"activityType":"EXECUTION","executionLegs":[{"legId":1,"price":2.5,"quantity":2},{"legId":2,"price":1.25,"quantity":2}]}]}`
+	accountResponse        = `{"securitiesAccount":{"type":"MARGIN","accountNumber":"12345678","positions":[{"longQuantity":0,"shortQuantity":100,"instrument":{"symbol":"SPY","assetType":"EQUITY"}},{"longQuantity":2,"shortQuantity":0,"instrument":{"symbol":"SPY   240119P00450000","assetType":"OPTION"}}],"currentBalances":{"liquidationValue":10000,"cashBalance":5000,"cashAvailableForTrading":0,"buyingPowerNonMarginableTrade":4000}}}`
+)
+
+func TestSchwab(t *testing.T) {
+	util.Conf.Storage.Dir = t.TempDir()
+	tokens = util.NewFileStore[*Token]("schwab_tokens")
+
+	refreshes := 0
+	// refresh tokens granted by the app
+	granted := map[string]bool{"refresh-1": true, "refresh-3": true}
+	server := accounttest.NewServer(
+		t, func(r *http.Request) bool {
+			return r.Header.Get("Authorization") == "Bearer access-1"
+		},
+	)
+	server.Mux.HandleFunc(
+		"/v1/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			user, pass, _ := r.BasicAuth()
+			if user != "key" || pass != "secret" || !granted[r.PostForm.Get("refresh_token")] {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			refreshes++
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(tokenResponse))
+		},
+	)
+	server.Handle("/trader/v1/accounts/accountNumbers", accountNumbersResponse)
+	server.Handle("/marketdata/v1/quotes", quotesResponse)
+	server.Handle("/marketdata/v1/expirationchain", expirationsResponse)
+	server.Handle("/marketdata/v1/chains", chainsResponse)
+	server.Handle("/marketdata/v1/markets/equity", marketsResponse)
+	server.Handle("/trader/v1/accounts/HASH", accountResponse)
+	server.Handle("/trader/v1/accounts/HASH/orders/1001", orderResponse)
+	placed := server.Record(
+		"/trader/v1/accounts/HASH/orders", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Location", "https://api.schwabapi.com/trader/v1/accounts/HASH/orders/1001")
+			w.WriteHeader(http.StatusCreated)
+		},
+	)
+
+	schwab := NewSchwab("key", "secret", "refresh-1")
+	schwab.client.SetBaseURL(server.URL)
+	schwab.accountNumber = "12345678"
+	ctx := context.Background()
+
+	quotes, err := schwab.GetQuotes(ctx, []string{"SPX", "SPY"})
+	assert.NoError(t, err)
+	assert.Equal(t, "SPX", quotes[0].Symbol)
+	assert.Equal(t, 4739.21, quotes[0].PrevClose)
+	assert.Equal(t, 482.44, quotes[1].Ask)
+
+	expirations, err := schwab.GetOptionExpirations(ctx, "SPX")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2024-01-19", "2024-01-22"}, expirations)
+
+	chains, err := schwab.GetOptionChains(ctx, "SPX", "2024-01-19")
+	assert.NoError(t, err)
+	assert.Len(t, chains, 2)
+	assert.Equal(t, "SPX", chains[0].RootSymbol)
+	assert.Equal(t, "SPX240119P04750000", chains[0].Puts[0].Symbol)
+	spxw := chains[1]
+	assert.Equal(t, "SPXW240119C04800000", spxw.Calls[0].Symbol)
+	assert.Equal(t, 0.125, spxw.Calls[0].Iv)
+	assert.Equal(t, int64(8811), spxw.Calls[0].OpenInterest)
+	assert.Equal(t, int64(1520), spxw.Calls[0].Volume)
+	assert.Equal(t, -0.45, spxw.Calls[0].Change)
+	// greeks schwab can't compute are zeros
+	assert.Equal(t, 0.0, spxw.Puts[0].Delta)
+
+	periods, err := schwab.GetTradeCalendar(ctx, "2024-01-19", "2024-01-19")
+	assert.NoError(t, err)
+	assert.True(t, periods[0].IsOpen)
+	assert.False(t, periods[0].IsEarlyClose)
+	assert.Equal(t, int64(1705674600000), periods[0].OpenAt)
+
+	id, err := schwab.PlaceOrder(
+		ctx, &account.Order{
+			Underlying: "SPY",
+			Legs: []account.OrderLeg{
+				{Symbol: "SPY240119P00460000", Side: account.SellToOpen, Quantity: 2},
+				{Symbol: "SPY240119P00450000", Side: account.BuyToOpen, Quantity: 2},
+			},
+			Price: -1.2,
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "1001", id)
+	body := <-placed
+	assert.Equal(t, "NET_CREDIT", body["orderType"])
+	assert.Equal(t, 1.2, body["price"])
+	assert.Equal(t, "CUSTOM", body["complexOrderStrategyType"])
+	leg := body["orderLegCollection"].([]any)[0].(map[string]any)
+	assert.Equal(t, "SELL_TO_OPEN", leg["instruction"])
+	assert.Equal(t, "SPY   240119P00460000", leg["instrument"].(map[string]any)["symbol"])
+
+	result, err := schwab.GetOrder(ctx, id)
+	assert.NoError(t, err)
+	assert.Equal(t, account.OrderStatusFilled, result.Status)
+	assert.InDelta(t, -1.25, result.FillPrice, 1e-9)
+	assert.Equal(t, 2.5, result.LegFillPrices["SPY240119P00460000"])
+	assert.Equal(t, 2.0, result.LegFilledQuantities["SPY240119P00460000"])
+
+	balances, err := schwab.GetBalances(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 4000.0, balances.OptionBuyingPower)
+	assert.Equal(t, 10000.0, balances.TotalEquity)
+
+	holdings, err := schwab.GetPositions(ctx)
+	assert.NoError(t, err)
+	assert.Equal(
+		t, []account.Holding{{Symbol: "SPY", Quantity: -100}, {Symbol: "SPY240119P00450000", Quantity: 2}},
+		holdings,
+	)
+
+	// the access token is refreshed once and the new refresh token is persisted
+	assert.Equal(t, 1, refreshes)
+	token, ok, err := tokens.Get("key_12345678")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "refresh-2", token.RefreshToken)
+	assert.Equal(t, "refresh-1", token.Configured)
+	// a new client of the app reuses the persisted token
+	again := NewSchwab("key", "secret", "refresh-1")
+	again.client.SetBaseURL(server.URL)
+	again.accountNumber = "12345678"
+	_, err = again.GetQuotes(ctx, []string{"SPY"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, refreshes)
+	// a newly configured refresh token is preferred over the persisted token
+	reauthorized := NewSchwab("key", "secret", "refresh-3")
+	reauthorized.client.SetBaseURL(server.URL)
+	reauthorized.accountNumber = "12345678"
+	_, err = reauthorized.GetQuotes(ctx, []string{"SPY"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, refreshes)
+	token, _, err = tokens.Get("key_12345678")
+	assert.NoError(t, err)
+	assert.Equal(t, "refresh-3", token.Configured)
+	// another account of the app keeps its own token
+	other := NewSchwab("key", "secret", "refresh-1")
+	other.client.SetBaseURL(server.URL)
+	other.accountNumber = "87654321"
+	_, err = other.GetQuotes(ctx, []string{"SPY"})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, refreshes)
+	token, _, err = tokens.Get("key_12345678")
+	assert.NoError(t, err)
+	assert.Equal(t, "refresh-3", token.Configured)
+}
+
+func TestOptionSymbol(t *testing.T) {
+	assert.Equal(t, "SPXW  240119P04700000", toOptionSymbol("SPXW240119P04700000"))
+	assert.Equal(t, "SPY   240119P00460000", toOptionSymbol("SPY240119P00460000"))
+	assert.Equal(t, "SPY", toOptionSymbol("SPY"))
+	assert.Equal(t, "SPXW240119P04700000", fromOptionSymbol("SPXW  240119P04700000"))
+}
@@ -2,12 +2,17 @@ package account
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"time"
 
 	"cdr.dev/slog"
 	"connectrpc.com/connect"
 	"github.com/ppaanngggg/option-bot/pkg/util"
 	v1 "github.com/ppaanngggg/option-bot/proto/gen/account/v1"
 	"github.com/ppaanngggg/option-bot/proto/gen/account/v1/accountv1connect"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
 )
 
 var Service accountv1connect.AccountServiceHandler
@@ -25,27 +30,111 @@ type service struct {
 func (s *service) Create(
 	ctx context.Context, req *connect.Request[v1.CreateRequest],
 ) (*connect.Response[v1.CreateResponse], error) {
-	//TODO implement me
-	panic("implement me")
+	if req.Msg.Name == "" || req.Msg.Setting == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, xerrors.New("name and setting are required"),
+		)
+	}
+	factoriesMu.RLock()
+	_, ok := factories[req.Msg.Setting.Type]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			xerrors.Errorf("unsupported account type: %s", req.Msg.Setting.Type),
+		)
+	}
+	record := &v1.GetResponse{
+		Id:        util.NewID(),
+		Name:      req.Msg.Name,
+		CreatedAt: time.Now().UnixMilli(),
+		Setting:   req.Msg.Setting,
+	}
+	if err := accounts.Put(record.Id, record); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.logger.Info(ctx, "account created", slog.F("id", record.Id), slog.F("name", record.Name))
+	return &connect.Response[v1.CreateResponse]{
+		Msg: &v1.CreateResponse{
+			Id:        record.Id,
+			Name:      record.Name,
+			CreatedAt: record.CreatedAt,
+			Setting:   redact(record).Setting,
+		},
+	}, nil
 }
 
 func (s *service) Get(
 	ctx context.Context, req *connect.Request[v1.GetRequest],
 ) (*connect.Response[v1.GetResponse], error) {
-	//TODO implement me
-	panic("implement me")
+	record, ok, err := accounts.Get(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if !ok {
+		return nil, connect.NewError(
+			connect.CodeNotFound, xerrors.Errorf("account not found, id: %s", req.Msg.Id),
+		)
+	}
+	return &connect.Response[v1.GetResponse]{Msg: redact(record)}, nil
 }
 
 func (s *service) List(
 	ctx context.Context, req *connect.Request[v1.ListRequest],
 ) (*connect.Response[v1.ListResponse], error) {
-	//TODO implement me
-	panic("implement me")
+	records, err := accounts.List(nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	sort.Slice(
+		records, func(i, j int) bool {
+			return records[i].CreatedAt < records[j].CreatedAt
+		},
+	)
+	list := make([]*v1.GetResponse, 0, len(records))
+	for _, record := range records {
+		list = append(list, redact(record))
+	}
+	return &connect.Response[v1.ListResponse]{
+		Msg: &v1.ListResponse{
+			List: list,
+		},
+	}, nil
+}
+
+// redact returns a copy of the record whose secrets only keep the last 4 characters
+func redact(record *v1.GetResponse) *v1.GetResponse {
+	record = proto.Clone(record).(*v1.GetResponse)
+	if tradier := record.GetSetting().GetTradier(); tradier != nil {
+		tradier.ApiKey = redactSecret(tradier.ApiKey)
+	}
+	if schwab := record.GetSetting().GetSchwab(); schwab != nil {
+		schwab.AppSecret = redactSecret(schwab.AppSecret)
+		schwab.RefreshToken = redactSecret(schwab.RefreshToken)
+	}
+	if alpaca := record.GetSetting().GetAlpaca(); alpaca != nil {
+		alpaca.ApiKey = redactSecret(alpaca.ApiKey)
+		alpaca.ApiSecret = redactSecret(alpaca.ApiSecret)
+	}
+	return record
+}
+
+func redactSecret(secret string) string {
+	if len(secret) < 12 {
+		return strings.Repeat("*", len(secret))
+	}
+	return strings.Repeat("*", len(secret)-4) + secret[len(secret)-4:]
 }
 
 func (s *service) Delete(
 	ctx context.Context, req *connect.Request[v1.DeleteRequest],
 ) (*connect.Response[v1.DeleteResponse], error) {
-	//TODO implement me
-	panic("implement me")
+	if err := accounts.Delete(req.Msg.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	closeClient(req.Msg.Id)
+	s.logger.Info(ctx, "account deleted", slog.F("id", req.Msg.Id))
+	return &connect.Response[v1.DeleteResponse]{
+		Msg: &v1.DeleteResponse{},
+	}, nil
 }
@@ -0,0 +1,95 @@
+package account
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	v1 "github.com/ppaanngggg/option-bot/proto/gen/account/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Redact(t *testing.T) {
+	util.Conf.Storage.Dir = t.TempDir()
+	accounts = util.NewFileStore[*v1.GetResponse]("accounts")
+	record := &v1.GetResponse{
+		Id: "acc",
+		Setting: &v1.Setting{
+			Type:    v1.AccountType_ACCOUNT_TYPE_TRADIER,
+			Tradier: &v1.Setting_Tradier{ApiKey: "abcdefgh12345678", AccountNumber: "VA000000"},
+		},
+	}
+	assert.NoError(t, accounts.Put(record.Id, record))
+
+	s := &service{}
+	ctx := context.Background()
+	resp, err := s.Get(ctx, connect.NewRequest(&v1.GetRequest{Id: "acc"}))
+	assert.NoError(t, err)
+	assert.Equal(t, "************5678", resp.Msg.Setting.Tradier.ApiKey)
+	assert.Equal(t, "VA000000", resp.Msg.Setting.Tradier.AccountNumber)
+	list, err := s.List(ctx, connect.NewRequest(&v1.ListRequest{}))
+	assert.NoError(t, err)
+	assert.Equal(t, "************5678", list.Msg.List[0].Setting.Tradier.ApiKey)
+
+	// the stored secret is kept to open the account
+	stored, _, _ := accounts.Get("acc")
+	assert.Equal(t, "abcdefgh12345678", stored.Setting.Tradier.ApiKey)
+
+	schwab := &v1.GetResponse{
+		Id: "schwab",
+		Setting: &v1.Setting{
+			Type: v1.AccountType_ACCOUNT_TYPE_SCHWAB,
+			Schwab: &v1.Setting_Schwab{
+				AppKey: "app-key", AppSecret: "secret", RefreshToken: "refresh-token-1234", AccountNumber: "12345678",
+			},
+		},
+	}
+	assert.NoError(t, accounts.Put(schwab.Id, schwab))
+	resp, err = s.Get(ctx, connect.NewRequest(&v1.GetRequest{Id: "schwab"}))
+	assert.NoError(t, err)
+	assert.Equal(t, "******", resp.Msg.Setting.Schwab.AppSecret)
+	assert.Equal(t, "**************1234", resp.Msg.Setting.Schwab.RefreshToken)
+	assert.Equal(t, "app-key", resp.Msg.Setting.Schwab.AppKey)
+
+	alpaca := &v1.GetResponse{
+		Id: "alpaca",
+		Setting: &v1.Setting{
+			Type:   v1.AccountType_ACCOUNT_TYPE_ALPACA,
+			Alpaca: &v1.Setting_Alpaca{ApiKey: "PKABCDEFGH1234", ApiSecret: "secret-abcdefgh5678"},
+		},
+	}
+	assert.NoError(t, accounts.Put(alpaca.Id, alpaca))
+	resp, err = s.Get(ctx, connect.NewRequest(&v1.GetRequest{Id: "alpaca"}))
+	assert.NoError(t, err)
+	assert.Equal(t, "**********1234", resp.Msg.Setting.Alpaca.ApiKey)
+	assert.Equal(t, "***************5678", resp.Msg.Setting.Alpaca.ApiSecret)
+}
+
+// closerMarket counts how many times it's closed
+type closerMarket struct {
+	Market
+	closed int
+}
+
+func (m *closerMarket) Close() error {
+	m.closed++
+	return nil
+}
+
+func TestService_Delete(t *testing.T) {
+	util.Conf.Storage.Dir = t.TempDir()
+	accounts = util.NewFileStore[*v1.GetResponse]("accounts")
+	market := &closerMarket{}
+	clientsMu.Lock()
+	clients["acc"] = &Client{ID: "acc", Market: market}
+	clientsMu.Unlock()
+
+	s := &service{}
+	_, err := s.Delete(context.Background(), connect.NewRequest(&v1.DeleteRequest{Id: "acc"}))
+	assert.NoError(t, err)
+	// the client is dropped and closed once
+	assert.Equal(t, 1, market.closed)
+	_, err = Open("acc")
+	assert.Error(t, err)
+}
@@ -0,0 +1,280 @@
+package tradier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	accountv1 "github.com/ppaanngggg/option-bot/proto/gen/account/v1"
+	"golang.org/x/xerrors"
+)
+
+func init() {
+	account.RegisterFactory(
+		accountv1.AccountType_ACCOUNT_TYPE_TRADIER,
+		func(setting *accountv1.Setting) (account.Market, account.Broker, error) {
+			s := setting.GetTradier()
+			if s.GetApiKey() == "" {
+				return nil, nil, xerrors.New("tradier api key is required")
+			}
+			t := NewTradier(s.IsLive, s.ApiKey)
+			t.accountNumber = s.AccountNumber
+			return t, t, nil
+		},
+	)
+}
+
+var _ account.Broker = (*Tradier)(nil)
+
+func (t *Tradier) checkAccountNumber() error {
+	if t.accountNumber == "" {
+		return xerrors.New("tradier account number is not set")
+	}
+	return nil
+}
+
+// PlaceOrder refer to https://documentation.tradier.com/brokerage-api/trading/place-multileg-order
+func (t *Tradier) PlaceOrder(ctx context.Context, order *account.Order) (string, error) {
+	if err := t.checkAccountNumber(); err != nil {
+		return "", err
+	}
+	if len(order.Legs) == 0 {
+		return "", xerrors.New("order has no legs")
+	}
+	params := map[string]string{
+		"symbol":   order.Underlying,
+		"duration": "day",
+	}
+	if len(order.Legs) == 1 {
+		leg := order.Legs[0]
+		params["class"] = "option"
+		params["option_symbol"] = leg.Symbol
+		params["side"] = leg.Side.String()
+		params["quantity"] = strconv.Itoa(int(leg.Quantity))
+		if order.IsMarket {
+			params["type"] = "market"
+		} else {
+			params["type"] = "limit"
+			params["price"] = formatPrice(math.Abs(order.Price))
+		}
+	} else {
+		params["class"] = "multileg"
+		for i, leg := range order.Legs {
+			params[fmt.Sprintf("option_symbol[%d]", i)] = leg.Symbol
+			params[fmt.Sprintf("side[%d]", i)] = leg.Side.String()
+			params[fmt.Sprintf("quantity[%d]", i)] = strconv.Itoa(int(leg.Quantity))
+		}
+		switch {
+		case order.IsMarket:
+			params["type"] = "market"
+		case order.Price > 0:
+			params["type"] = "debit"
+			params["price"] = formatPrice(order.Price)
+		case order.Price < 0:
+			params["type"] = "credit"
+			params["price"] = formatPrice(-order.Price)
+		default:
+			params["type"] = "even"
+		}
+	}
+	body := &struct {
+		Order struct {
+			ID     int64  `json:"id"`
+			Status string `json:"status"`
+		} `json:"order"`
+	}{}
+	resp, err := t.client.R().
+		SetContext(ctx).
+		SetAuthToken(t.apiKey).
+		SetHeader("Accept", "application/json").
+		SetFormData(params).
+		SetResult(body).
+		Post("/accounts/" + t.accountNumber + "/orders")
+	if err := account.CheckResponse("place order", resp, err); err != nil {
+		return "", err
+	}
+	if body.Order.Status != "ok" {
+		return "", account.MalformedError(
+			"place order", xerrors.Errorf("unexpected status: %s", body.Order.Status),
+		)
+	}
+	return strconv.FormatInt(body.Order.ID, 10), nil
+}
+
+// GetOrder refer to https://documentation.tradier.com/brokerage-api/accounts/get-account-order
+func (t *Tradier) GetOrder(ctx context.Context, id string) (*account.OrderResult, error) {
+	if err := t.checkAccountNumber(); err != nil {
+		return nil, err
+	}
+	body := &struct {
+		Order struct {
+			ID           int64   `json:"id"`
+			Type         string  `json:"type"` // market, limit, debit, credit or even
+			Status       string  `json:"status"`
+			AvgFillPrice float64 `json:"avg_fill_price"`
+			Leg          []struct {
+				OptionSymbol string  `json:"option_symbol"`
+				Side         string  `json:"side"`
+				AvgFillPrice float64 `json:"avg_fill_price"`
+				ExecQuantity float64 `json:"exec_quantity"`
+			} `json:"leg"`
+		} `json:"order"`
+	}{}
+	resp, err := t.client.R().
+		SetContext(ctx).
+		SetAuthToken(t.apiKey).
+		SetHeader("Accept", "application/json").
+		SetResult(body).
+		Get("/accounts/" + t.accountNumber + "/orders/" + id)
+	if err := account.CheckResponse("get order", resp, err); err != nil {
+		return nil, err
+	}
+	result := &account.OrderResult{
+		ID:                  id,
+		LegFillPrices:       make(map[string]float64, len(body.Order.Leg)),
+		LegFilledQuantities: make(map[string]float64, len(body.Order.Leg)),
+	}
+	switch body.Order.Status {
+	case "filled":
+		result.Status = account.OrderStatusFilled
+	case "partially_filled":
+		result.Status = account.OrderStatusPartiallyFilled
+	case "canceled":
+		result.Status = account.OrderStatusCanceled
+	case "expired":
+		result.Status = account.OrderStatusExpired
+	case "rejected", "error":
+		result.Status = account.OrderStatusRejected
+	default:
+		result.Status = account.OrderStatusPending
+	}
+	// the net fill price is unsigned, a credit order fills for a credit
+	result.FillPrice = body.Order.AvgFillPrice
+	if body.Order.Type == "credit" {
+		result.FillPrice = -math.Abs(result.FillPrice)
+	}
+	for _, leg := range body.Order.Leg {
+		result.LegFillPrices[leg.OptionSymbol] = leg.AvgFillPrice
+		result.LegFilledQuantities[leg.OptionSymbol] = leg.ExecQuantity
+	}
+	return result, nil
+}
+
+// CancelOrder refer to https://documentation.tradier.com/brokerage-api/trading/cancel-order
+func (t *Tradier) CancelOrder(ctx context.Context, id string) error {
+	if err := t.checkAccountNumber(); err != nil {
+		return err
+	}
+	resp, err := t.client.R().
+		SetContext(ctx).
+		SetAuthToken(t.apiKey).
+		SetHeader("Accept", "application/json").
+		Delete("/accounts/" + t.accountNumber + "/orders/" + id)
+	return account.CheckResponse("cancel order", resp, err)
+}
+
+// GetBalances refer to https://documentation.tradier.com/brokerage-api/accounts/get-account-balance
+func (t *Tradier) GetBalances(ctx context.Context) (*account.Balances, error) {
+	if err := t.checkAccountNumber(); err != nil {
+		return nil, err
+	}
+	body := &struct {
+		Balances struct {
+			AccountType string  `json:"account_type"` // margin, cash or pdt
+			TotalEquity float64 `json:"total_equity"`
+			TotalCash   float64 `json:"total_cash"`
+			Margin      struct {
+				OptionBuyingPower float64 `json:"option_buying_power"`
+			} `json:"margin"`
+			Cash struct {
+				CashAvailable float64 `json:"cash_available"`
+			} `json:"cash"`
+			PDT struct {
+				OptionBuyingPower float64 `json:"option_buying_power"`
+			} `json:"pdt"`
+		} `json:"balances"`
+	}{}
+	resp, err := t.client.R().
+		SetContext(ctx).
+		SetAuthToken(t.apiKey).
+		SetHeader("Accept", "application/json").
+		SetResult(body).
+		Get("/accounts/" + t.accountNumber + "/balances")
+	if err := account.CheckResponse("get balances", resp, err); err != nil {
+		return nil, err
+	}
+	b := body.Balances
+	balances := &account.Balances{
+		TotalEquity:   b.TotalEquity,
+		CashAvailable: b.TotalCash,
+	}
+	switch b.AccountType {
+	case "margin":
+		balances.OptionBuyingPower = b.Margin.OptionBuyingPower
+	case "pdt":
+		balances.OptionBuyingPower = b.PDT.OptionBuyingPower
+	default:
+		// a cash account can only spend its cash
+		balances.CashAvailable = b.Cash.CashAvailable
+		balances.OptionBuyingPower = b.Cash.CashAvailable
+	}
+	return balances, nil
+}
+
+// GetPositions refer to https://documentation.tradier.com/brokerage-api/accounts/get-account-positions
+func (t *Tradier) GetPositions(ctx context.Context) ([]account.Holding, error) {
+	if err := t.checkAccountNumber(); err != nil {
+		return nil, err
+	}
+	type position struct {
+		Symbol   string  `json:"symbol"`
+		Quantity float64 `json:"quantity"`
+	}
+	body := &struct {
+		// "null" for no position
+		Positions json.RawMessage `json:"positions"`
+	}{}
+	resp, err := t.client.R().
+		SetContext(ctx).
+		SetAuthToken(t.apiKey).
+		SetHeader("Accept", "application/json").
+		SetResult(body).
+		Get("/accounts/" + t.accountNumber + "/positions")
+	if err := account.CheckResponse("get positions", resp, err); err != nil {
+		return nil, err
+	}
+	var raws []position
+	if raw := bytes.TrimSpace(body.Positions); len(raw) > 0 && raw[0] == '{' {
+		positions := &struct {
+			// an object for a single position, an array for many
+			Position json.RawMessage `json:"position"`
+		}{}
+		if err := json.Unmarshal(raw, positions); err != nil {
+			return nil, account.MalformedError("get positions", err)
+		}
+		if raw = bytes.TrimSpace(positions.Position); len(raw) > 0 && raw[0] == '{' {
+			single := position{}
+			if err := json.Unmarshal(raw, &single); err != nil {
+				return nil, account.MalformedError("get positions", err)
+			}
+			raws = append(raws, single)
+		} else if len(raw) > 0 && raw[0] == '[' {
+			if err := json.Unmarshal(raw, &raws); err != nil {
+				return nil, account.MalformedError("get positions", err)
+			}
+		}
+	}
+	holdings := make([]account.Holding, 0, len(raws))
+	for _, p := range raws {
+		holdings = append(holdings, account.Holding{Symbol: p.Symbol, Quantity: p.Quantity})
+	}
+	return holdings, nil
+}
+
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', 2, 64)
+}
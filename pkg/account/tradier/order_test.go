@@ -0,0 +1,84 @@
+package tradier
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTradier_Orders(t *testing.T) {
+	form := make(chan url.Values, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		"/accounts/VA1/orders", func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			form <- r.PostForm
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"order":{"id":42,"status":"ok"}}`))
+		},
+	)
+	mux.HandleFunc(
+		"/accounts/VA1/orders/42", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"order":{"id":42,"type":"credit","status":"filled","avg_fill_price":1.25,"leg":[{"option_symbol":"SPY240119P00460000","avg_fill_price":2.5,"exec_quantity":2.0},{"option_symbol":"SPY240119P00450000","avg_fill_price":1.25}]}}`))
+		},
+	)
+	mux.HandleFunc(
+		"/accounts/VA1/balances", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"balances":{"account_type":"margin","total_equity":10000,"total_cash":5000,"margin":{"option_buying_power":4000}}}`))
+		},
+	)
+	mux.HandleFunc(
+		"/accounts/VA1/positions", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"positions":{"position":{"symbol":"SPY","quantity":-200.0}}}`))
+		},
+	)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tradier := NewTradier(false, "")
+	tradier.client.SetBaseURL(server.URL)
+	tradier.accountNumber = "VA1"
+
+	id, err := tradier.PlaceOrder(
+		context.Background(), &account.Order{
+			Underlying: "SPY",
+			Legs: []account.OrderLeg{
+				{Symbol: "SPY240119P00460000", Side: account.SellToOpen, Quantity: 2},
+				{Symbol: "SPY240119P00450000", Side: account.BuyToOpen, Quantity: 2},
+			},
+			Price: -1.2,
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "42", id)
+	params := <-form
+	assert.Equal(t, "multileg", params.Get("class"))
+	assert.Equal(t, "credit", params.Get("type"))
+	assert.Equal(t, "1.20", params.Get("price"))
+	assert.Equal(t, "sell_to_open", params.Get("side[0]"))
+	assert.Equal(t, "2", params.Get("quantity[1]"))
+
+	result, err := tradier.GetOrder(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, account.OrderStatusFilled, result.Status)
+	assert.Equal(t, -1.25, result.FillPrice)
+	assert.Equal(t, 2.5, result.LegFillPrices["SPY240119P00460000"])
+	assert.Equal(t, 2.0, result.LegFilledQuantities["SPY240119P00460000"])
+
+	balances, err := tradier.GetBalances(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 4000.0, balances.OptionBuyingPower)
+	assert.Equal(t, 10000.0, balances.TotalEquity)
+
+	holdings, err := tradier.GetPositions(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []account.Holding{{Symbol: "SPY", Quantity: -200}}, holdings)
+}
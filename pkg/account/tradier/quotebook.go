@@ -0,0 +1,120 @@
+package tradier
+
+import (
+	"sync"
+
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+)
+
+// quoteBookBuffer is how many updates a subscriber may fall behind before updates are dropped
+const quoteBookBuffer = 256
+
+// Quote is the latest top of book and last trade of a symbol pushed by the stream
+type Quote struct {
+	Symbol   string
+	Bid      float64
+	BidSize  int32
+	BidAt    int64 // unix timestamp in ms
+	Ask      float64
+	AskSize  int32
+	AskAt    int64 // unix timestamp in ms
+	Last     float64
+	LastSize int32
+	LastAt   int64 // unix timestamp in ms
+}
+
+// QuoteBook keeps the latest streamed quote keyed by symbol, it is safe for concurrent use
+type QuoteBook struct {
+	mu          sync.RWMutex
+	quotes      map[string]*Quote
+	subscribers map[chan Quote]struct{}
+}
+
+func NewQuoteBook() *QuoteBook {
+	return &QuoteBook{
+		quotes:      make(map[string]*Quote),
+		subscribers: make(map[chan Quote]struct{}),
+	}
+}
+
+// Get returns a copy of the latest quote of the symbol
+func (b *QuoteBook) Get(symbol string) (Quote, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	q, ok := b.quotes[symbol]
+	if !ok {
+		return Quote{}, false
+	}
+	return *q, true
+}
+
+// Subscribe returns a channel receiving every quote update and a func to unsubscribe,
+// updates are dropped for a subscriber which can't keep up
+func (b *QuoteBook) Subscribe() (<-chan Quote, func()) {
+	ch := make(chan Quote, quoteBookBuffer)
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[ch]; ok {
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// remove drops the quotes of the symbols
+func (b *QuoteBook) remove(symbols ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, symbol := range symbols {
+		delete(b.quotes, symbol)
+	}
+}
+
+// update merges the changes into the quote of the symbol and notifies subscribers
+func (b *QuoteBook) update(symbol string, apply func(q *Quote)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	q, ok := b.quotes[symbol]
+	if !ok {
+		q = &Quote{Symbol: symbol}
+		b.quotes[symbol] = q
+	}
+	apply(q)
+	for ch := range b.subscribers {
+		select {
+		case ch <- *q:
+		default:
+		}
+	}
+}
+
+// ApplyTo overwrites bid, ask and last trade of options in chains with the streamed quotes which are newer
+func (b *QuoteBook) ApplyTo(chains []*datasourcev1.Chain) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	apply := func(opts []*datasourcev1.Option) {
+		for _, opt := range opts {
+			q, ok := b.quotes[opt.Symbol]
+			if !ok {
+				continue
+			}
+			if q.BidAt > opt.BidAt {
+				opt.Bid, opt.BidSize, opt.BidAt = q.Bid, q.BidSize, q.BidAt
+			}
+			if q.AskAt > opt.AskAt {
+				opt.Ask, opt.AskSize, opt.AskAt = q.Ask, q.AskSize, q.AskAt
+			}
+			if q.LastAt > opt.LastAt {
+				opt.Last, opt.LastVolume, opt.LastAt = q.Last, int64(q.LastSize), q.LastAt
+			}
+		}
+	}
+	for _, chain := range chains {
+		apply(chain.Calls)
+		apply(chain.Puts)
+	}
+}
@@ -0,0 +1,342 @@
+package tradier
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/net/websocket"
+	"golang.org/x/xerrors"
+)
+
+const (
+	streamMinBackoff = time.Second
+	streamMaxBackoff = time.Minute
+)
+
+// Stream pushes quotes and trades of the subscribed symbols into a QuoteBook,
+// refer to https://documentation.tradier.com/brokerage-api/streaming/wss-market-websocket
+type Stream struct {
+	tradier *Tradier
+	book    *QuoteBook
+	logger  slog.Logger
+
+	mu      sync.Mutex
+	symbols map[string]struct{}
+	// the live connection and its session, nil while reconnecting
+	conn      *websocket.Conn
+	sessionID string
+}
+
+func (t *Tradier) NewStream(book *QuoteBook) *Stream {
+	return &Stream{
+		tradier: t,
+		book:    book,
+		logger:  t.logger.With(slog.F("tradier", "stream")),
+		symbols: make(map[string]struct{}),
+	}
+}
+
+// Subscribe adds option or underlying symbols to the stream, takes effect immediately if connected
+func (s *Stream) Subscribe(symbols ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, symbol := range symbols {
+		s.symbols[symbol] = struct{}{}
+	}
+	return s.sendSubscriptionLocked()
+}
+
+// Unsubscribe removes symbols from the stream, their last quotes are kept in the book
+func (s *Stream) Unsubscribe(symbols ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, symbol := range symbols {
+		delete(s.symbols, symbol)
+	}
+	return s.sendSubscriptionLocked()
+}
+
+// sendSubscriptionLocked sends the full symbol list, which replaces the previous subscription
+func (s *Stream) sendSubscriptionLocked() error {
+	if s.conn == nil || len(s.symbols) == 0 {
+		return nil
+	}
+	symbols := make([]string, 0, len(s.symbols))
+	for symbol := range s.symbols {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	payload := map[string]any{
+		"symbols":   symbols,
+		"sessionid": s.sessionID,
+		"filter":    []string{"quote", "trade"},
+		"linebreak": true,
+	}
+	if err := websocket.JSON.Send(s.conn, payload); err != nil {
+		return xerrors.New(err.Error())
+	}
+	return nil
+}
+
+// Run connects and reads the stream until ctx is done, reconnecting with backoff when the connection drops
+func (s *Stream) Run(ctx context.Context) error {
+	if s.tradier.streamURL == "" {
+		return xerrors.New("streaming is only available for live accounts")
+	}
+	backoff := streamMinBackoff
+	for {
+		connectedAt := time.Now()
+		err := s.connectAndRead(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		// a connection which lived long enough is a drop rather than a failure to connect
+		if time.Since(connectedAt) > streamMaxBackoff {
+			backoff = streamMinBackoff
+		}
+		s.logger.Warn(
+			ctx, "stream disconnected, reconnecting", slog.Error(err), slog.F("backoff", backoff),
+		)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, streamMaxBackoff)
+	}
+}
+
+func (s *Stream) connectAndRead(ctx context.Context) error {
+	// a session expires in 5 minutes if not connected, so create a new one for each connection
+	sessionID, err := s.tradier.createStreamSession(ctx)
+	if err != nil {
+		return err
+	}
+	config, err := websocket.NewConfig(s.tradier.streamURL, "http://localhost/")
+	if err != nil {
+		return xerrors.New(err.Error())
+	}
+	conn, err := websocket.DialConfig(config)
+	if err != nil {
+		return xerrors.New(err.Error())
+	}
+	// unblock the reader below when ctx is done
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	s.mu.Lock()
+	s.conn = conn
+	s.sessionID = sessionID
+	err = s.sendSubscriptionLocked()
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.conn = nil
+		s.mu.Unlock()
+		conn.Close()
+	}()
+	if err != nil {
+		return err
+	}
+	s.logger.Info(ctx, "stream connected")
+
+	for {
+		var msg string
+		if err := websocket.Message.Receive(conn, &msg); err != nil {
+			return xerrors.New(err.Error())
+		}
+		// a frame may carry several events separated by line breaks
+		for _, line := range strings.Split(msg, "\n") {
+			if line = strings.TrimSpace(line); line == "" {
+				continue
+			}
+			if err := s.handleEvent([]byte(line)); err != nil {
+				s.logger.Warn(ctx, "failed to handle stream event", slog.Error(err))
+			}
+		}
+	}
+}
+
+// streamEvent covers quote and trade events, numbers may come as json strings
+type streamEvent struct {
+	Type    string      `json:"type"`
+	Symbol  string      `json:"symbol"`
+	Bid     json.Number `json:"bid"`
+	BidSize json.Number `json:"bidsz"`
+	BidDate json.Number `json:"biddate"`
+	Ask     json.Number `json:"ask"`
+	AskSize json.Number `json:"asksz"`
+	AskDate json.Number `json:"askdate"`
+	Price   json.Number `json:"price"`
+	Size    json.Number `json:"size"`
+	Date    json.Number `json:"date"`
+	Error   string      `json:"error"`
+}
+
+func (s *Stream) handleEvent(data []byte) error {
+	event := &streamEvent{}
+	if err := json.Unmarshal(data, event); err != nil {
+		return xerrors.Errorf("failed to parse stream event %s: %w", data, err)
+	}
+	if event.Error != "" {
+		return xerrors.Errorf("stream error: %s", event.Error)
+	}
+	float := func(n json.Number) float64 {
+		v, _ := n.Float64()
+		return v
+	}
+	integer := func(n json.Number) int64 {
+		v, _ := n.Int64()
+		return v
+	}
+	switch event.Type {
+	case "quote":
+		s.book.update(
+			event.Symbol, func(q *Quote) {
+				q.Bid = float(event.Bid)
+				q.BidSize = int32(integer(event.BidSize))
+				q.BidAt = integer(event.BidDate)
+				q.Ask = float(event.Ask)
+				q.AskSize = int32(integer(event.AskSize))
+				q.AskAt = integer(event.AskDate)
+			},
+		)
+	case "trade":
+		s.book.update(
+			event.Symbol, func(q *Quote) {
+				q.Last = float(event.Price)
+				q.LastSize = int32(integer(event.Size))
+				q.LastAt = integer(event.Date)
+			},
+		)
+	}
+	return nil
+}
+
+// createStreamSession refer to https://documentation.tradier.com/brokerage-api/streaming/create-market-session
+func (t *Tradier) createStreamSession(ctx context.Context) (string, error) {
+	body := &struct {
+		Stream struct {
+			URL       string `json:"url"`
+			SessionID string `json:"sessionid"`
+		} `json:"stream"`
+	}{}
+	resp, err := t.client.R().
+		SetContext(ctx).
+		SetAuthToken(t.apiKey).
+		SetHeader("Accept", "application/json").
+		SetResult(body).
+		Post("/markets/events/session")
+	if err := account.CheckResponse("create stream session", resp, err); err != nil {
+		return "", err
+	}
+	if body.Stream.SessionID == "" {
+		return "", account.MalformedError(
+			"create stream session", xerrors.Errorf("empty session id, body: %s", resp.String()),
+		)
+	}
+	return body.Stream.SessionID, nil
+}
+
+// streamIdle is how long a symbol stays subscribed after its chain was last fetched
+const streamIdle = 5 * time.Minute
+
+// liveQuotes streams the quotes of the fetched option chains, so that the next fetch gets the
+// streamed bid, ask and last trade when they are newer than the polled ones
+type liveQuotes struct {
+	stream *Stream
+	book   *QuoteBook
+	// stops the stream
+	cancel context.CancelFunc
+
+	mu     sync.Mutex
+	usedAt map[string]time.Time
+}
+
+// applyLiveQuotes starts the stream on first use, overwrites chains by the streamed quotes,
+// and subscribes the options of chains
+func (t *Tradier) applyLiveQuotes(chains []*datasourcev1.Chain) {
+	if t.streamURL == "" || !util.Conf.Market.Stream {
+		return
+	}
+	t.liveOnce.Do(
+		func() {
+			book := NewQuoteBook()
+			ctx, cancel := context.WithCancel(context.Background())
+			t.live = &liveQuotes{
+				stream: t.NewStream(book), book: book, cancel: cancel, usedAt: make(map[string]time.Time),
+			}
+			go func() {
+				if err := t.live.stream.Run(ctx); err != nil {
+					t.logger.Error(ctx, "stream stopped", slog.Error(err))
+				}
+			}()
+		},
+	)
+	// closed before the first use
+	if t.live == nil {
+		return
+	}
+	t.live.book.ApplyTo(chains)
+	t.live.track(chains, time.Now())
+}
+
+// Close stops the stream of the live quotes, and keeps it from starting if not started yet,
+// Tradier allows only one streaming session per account, so close a client before replacing it
+func (t *Tradier) Close() error {
+	t.liveOnce.Do(func() {})
+	if t.live != nil {
+		t.live.cancel()
+	}
+	return nil
+}
+
+// track subscribes the new symbols of chains and unsubscribes the idle ones
+func (l *liveQuotes) track(chains []*datasourcev1.Chain, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var added, idle []string
+	for _, chain := range chains {
+		for _, options := range [][]*datasourcev1.Option{chain.Calls, chain.Puts} {
+			for _, o := range options {
+				if _, ok := l.usedAt[o.Symbol]; !ok {
+					added = append(added, o.Symbol)
+				}
+				l.usedAt[o.Symbol] = now
+			}
+		}
+	}
+	for symbol, usedAt := range l.usedAt {
+		if now.Sub(usedAt) > streamIdle {
+			idle = append(idle, symbol)
+			delete(l.usedAt, symbol)
+		}
+	}
+	ctx := context.Background()
+	if len(added) > 0 {
+		if err := l.stream.Subscribe(added...); err != nil {
+			l.stream.logger.Warn(ctx, "failed to subscribe", slog.Error(err))
+		}
+	}
+	if len(idle) > 0 {
+		if err := l.stream.Unsubscribe(idle...); err != nil {
+			l.stream.logger.Warn(ctx, "failed to unsubscribe", slog.Error(err))
+		}
+		l.book.remove(idle...)
+	}
+}
@@ -0,0 +1,120 @@
+package tradier
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+)
+
+func TestStream_Run(t *testing.T) {
+	subscribed := make(chan map[string]any, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		"/markets/events/session", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"stream":{"url":"","sessionid":"s-1"}}`))
+		},
+	)
+	mux.Handle(
+		"/markets/events", websocket.Handler(
+			func(conn *websocket.Conn) {
+				payload := map[string]any{}
+				if err := websocket.JSON.Receive(conn, &payload); err != nil {
+					return
+				}
+				subscribed <- payload
+				websocket.Message.Send(
+					conn,
+					`{"type":"quote","symbol":"SPY240119C00470000","bid":1.1,"bidsz":10,"biddate":"1705500000000","ask":1.2,"asksz":5,"askdate":"1705500000000"}`+"\n"+
+						`{"type":"trade","symbol":"SPY240119C00470000","price":"1.15","size":"3","date":"1705500001000"}`,
+				)
+				// keep the connection open until the client closes it
+				var msg string
+				websocket.Message.Receive(conn, &msg)
+			},
+		),
+	)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tradier := NewTradier(false, "")
+	tradier.client.SetBaseURL(server.URL)
+	tradier.streamURL = "ws" + strings.TrimPrefix(server.URL, "http") + "/markets/events"
+
+	book := NewQuoteBook()
+	updates, unsubscribe := book.Subscribe()
+	defer unsubscribe()
+	stream := tradier.NewStream(book)
+	assert.NoError(t, stream.Subscribe("SPY240119C00470000"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go stream.Run(ctx)
+
+	payload := <-subscribed
+	assert.Equal(t, "s-1", payload["sessionid"])
+	assert.Equal(t, []any{"SPY240119C00470000"}, payload["symbols"])
+
+	<-updates
+	last := <-updates
+	assert.Equal(t, 1.15, last.Last)
+
+	quote, ok := book.Get("SPY240119C00470000")
+	assert.True(t, ok)
+	assert.Equal(t, 1.1, quote.Bid)
+	assert.Equal(t, int32(5), quote.AskSize)
+	assert.Equal(t, int64(1705500000000), quote.AskAt)
+
+	chains := []*datasourcev1.Chain{
+		{Calls: []*datasourcev1.Option{{Symbol: "SPY240119C00470000", Bid: 1, BidAt: 1}}},
+	}
+	book.ApplyTo(chains)
+	assert.Equal(t, 1.1, chains[0].Calls[0].Bid)
+
+	select {
+	case <-updates:
+		t.Fatal("unexpected update")
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestLiveQuotes_Track(t *testing.T) {
+	book := NewQuoteBook()
+	live := &liveQuotes{stream: NewTradier(false, "").NewStream(book), book: book, usedAt: make(map[string]time.Time)}
+	now := time.Now()
+	live.track(
+		[]*datasourcev1.Chain{
+			{Calls: []*datasourcev1.Option{{Symbol: "C1"}}, Puts: []*datasourcev1.Option{{Symbol: "P1"}}},
+		}, now,
+	)
+	assert.Len(t, live.stream.symbols, 2)
+	book.update("C1", func(q *Quote) { q.Bid = 1 })
+
+	// C1 is idle after another chain is fetched long enough later
+	live.track(
+		[]*datasourcev1.Chain{{Puts: []*datasourcev1.Option{{Symbol: "P1"}}}}, now.Add(streamIdle/2),
+	)
+	live.track(
+		[]*datasourcev1.Chain{{Puts: []*datasourcev1.Option{{Symbol: "P1"}}}}, now.Add(streamIdle+time.Second),
+	)
+	assert.Len(t, live.stream.symbols, 1)
+	_, ok := book.Get("C1")
+	assert.False(t, ok)
+}
+
+func TestTradier_Close(t *testing.T) {
+	tradier := NewTradier(false, "")
+	tradier.streamURL = "ws://127.0.0.1:0/markets/events"
+	assert.NoError(t, tradier.Close())
+
+	// a closed client never starts the stream
+	tradier.applyLiveQuotes([]*datasourcev1.Chain{{Puts: []*datasourcev1.Option{{Symbol: "P1"}}}})
+	assert.Nil(t, tradier.live)
+}
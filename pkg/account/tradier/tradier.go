@@ -1,12 +1,20 @@
 package tradier
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"cdr.dev/slog"
 	"github.com/go-resty/resty/v2"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
 	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
 	"golang.org/x/xerrors"
 )
@@ -20,19 +28,30 @@ func NewTradier(isLive bool, apiKey string) *Tradier {
 	}
 	if tradier.isLive {
 		tradier.client.SetBaseURL("https://api.tradier.com/v1/")
+		// streaming is only available for live accounts
+		tradier.streamURL = "wss://ws.tradier.com/v1/markets/events"
 	} else {
 		tradier.client.SetBaseURL("https://sandbox.tradier.com/v1/")
 	}
+	account.SetRetry(tradier.client, tradier.logger)
 	return tradier
 }
 
-var _ account.Market = (*Tradier)(nil)
+var (
+	_ account.Market = (*Tradier)(nil)
+	_ io.Closer      = (*Tradier)(nil)
+)
 
 type Tradier struct {
-	isLive bool
-	apiKey string
-	client *resty.Client
-	logger slog.Logger
+	isLive        bool
+	apiKey        string
+	accountNumber string
+	streamURL     string
+	client        *resty.Client
+	logger        slog.Logger
+
+	liveOnce sync.Once
+	live     *liveQuotes
 }
 
 // Search refer to https://documentation.tradier.com/brokerage-api/markets/get-lookup
@@ -60,14 +79,8 @@ func (t *Tradier) Search(ctx context.Context, query string) (
 		).
 		SetResult(body).
 		Get("/markets/lookup")
-	if err != nil {
-		return nil, xerrors.New(err.Error())
-	}
-	if resp.IsError() {
-		return nil, xerrors.Errorf(
-			"failed to search symbols, status: %s, body: %s",
-			resp.Status(), resp.String(),
-		)
+	if err := account.CheckResponse("search symbols", resp, err); err != nil {
+		return nil, err
 	}
 	// Convert to datasourcev1.Symbol
 	symbols := make([]*datasourcev1.Symbol, 0, len(body.Securities.Security))
@@ -91,30 +104,140 @@ func (t *Tradier) Search(ctx context.Context, query string) (
 	return symbols, nil
 }
 
+// GetQuotes refer to https://documentation.tradier.com/brokerage-api/markets/get-quotes
+func (t *Tradier) GetQuotes(ctx context.Context, symbols []string) (
+	[]*datasourcev1.Quote, error,
+) {
+	type quote struct {
+		Symbol           string  `json:"symbol"`
+		Last             float64 `json:"last"`
+		Bid              float64 `json:"bid"`
+		Ask              float64 `json:"ask"`
+		Open             float64 `json:"open"`
+		High             float64 `json:"high"`
+		Low              float64 `json:"low"`
+		PrevClose        float64 `json:"prevclose"`
+		ChangePercentage float64 `json:"change_percentage"`
+		Volume           int64   `json:"volume"`
+		TradeDate        int64   `json:"trade_date"`
+	}
+	body := &struct {
+		Quotes struct {
+			// an object for a single symbol, an array for many
+			Quote json.RawMessage `json:"quote"`
+		} `json:"quotes"`
+	}{}
+	resp, err := t.client.R().
+		SetContext(ctx).
+		SetAuthToken(t.apiKey).
+		SetHeader("Accept", "application/json").
+		SetQueryParams(
+			map[string]string{
+				"symbols": strings.Join(symbols, ","),
+			},
+		).
+		SetResult(body).
+		Get("/markets/quotes")
+	if err := account.CheckResponse("get quotes", resp, err); err != nil {
+		return nil, err
+	}
+	var raws []quote
+	if raw := bytes.TrimSpace(body.Quotes.Quote); len(raw) > 0 && raw[0] == '{' {
+		single := quote{}
+		if err := json.Unmarshal(raw, &single); err != nil {
+			return nil, account.MalformedError("get quotes", err)
+		}
+		raws = append(raws, single)
+	} else if len(raw) > 0 && raw[0] == '[' {
+		if err := json.Unmarshal(raw, &raws); err != nil {
+			return nil, account.MalformedError("get quotes", err)
+		}
+	}
+	quotes := make([]*datasourcev1.Quote, 0, len(raws))
+	for _, q := range raws {
+		quotes = append(
+			quotes, &datasourcev1.Quote{
+				Symbol:           q.Symbol,
+				Last:             q.Last,
+				Bid:              q.Bid,
+				Ask:              q.Ask,
+				Open:             q.Open,
+				High:             q.High,
+				Low:              q.Low,
+				PrevClose:        q.PrevClose,
+				ChangePercentage: q.ChangePercentage,
+				Volume:           q.Volume,
+				QuoteAt:          q.TradeDate,
+			},
+		)
+	}
+	return quotes, nil
+}
+
 // GetTodayTradePeriod refer to https://documentation.tradier.com/brokerage-api/markets/get-calendar
 func (t *Tradier) GetTodayTradePeriod(ctx context.Context) (
 	*datasourcev1.TradePeriod, error,
 ) {
+	// get today's date in the format "YYYY-MM-DD" of New York timezone
+	today := time.Now().In(util.TZNewYork).Format("2006-01-02")
+	periods, err := t.GetTradeCalendar(ctx, today, today)
+	if err != nil {
+		return nil, err
+	}
+	if len(periods) == 0 {
+		return nil, xerrors.Errorf("today's trade period not found, today: %s", today)
+	}
+	return periods[0], nil
+}
+
+// GetTradeCalendar refer to https://documentation.tradier.com/brokerage-api/markets/get-calendar
+func (t *Tradier) GetTradeCalendar(
+	ctx context.Context, from string, to string,
+) ([]*datasourcev1.TradePeriod, error) {
+	start, err := time.ParseInLocation("2006-01-02", from, util.TZNewYork)
+	if err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	end, err := time.ParseInLocation("2006-01-02", to, util.TZNewYork)
+	if err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	// the calendar is queried month by month
+	var periods []*datasourcev1.TradePeriod
+	month := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, util.TZNewYork)
+	for ; !month.After(end); month = month.AddDate(0, 1, 0) {
+		monthly, err := t.getMonthCalendar(ctx, month.Year(), month.Month())
+		if err != nil {
+			return nil, err
+		}
+		for _, period := range monthly {
+			if period.Date >= from && period.Date <= to {
+				periods = append(periods, period)
+			}
+		}
+	}
+	return periods, nil
+}
+
+func (t *Tradier) getMonthCalendar(
+	ctx context.Context, year int, month time.Month,
+) ([]*datasourcev1.TradePeriod, error) {
+	type session struct {
+		Start string `json:"start"` // 09:30
+		End   string `json:"end"`   // 16:00
+	}
 	body := &struct {
 		Calendar struct {
 			Month int `json:"month"`
 			Year  int `json:"year"`
 			Days  struct {
 				Day []struct {
-					Date      string `json:"date"`
-					Status    string `json:"status"` // open or closed
-					Premarket struct {
-						Start string `json:"start"`
-						End   string `json:"end"`
-					} `json:"premarket"`
-					Open struct {
-						Start string `json:"start"` // 09:30
-						End   string `json:"end"`   // 16:00
-					} `json:"open"`
-					Postmarket struct {
-						Start string `json:"start"`
-						End   string `json:"end"`
-					} `json:"postmarket"`
+					Date        string  `json:"date"`
+					Status      string  `json:"status"` // open or closed
+					Description string  `json:"description"`
+					Premarket   session `json:"premarket"`
+					Open        session `json:"open"`
+					Postmarket  session `json:"postmarket"`
 				} `json:"day"`
 			} `json:"days"`
 		} `json:"calendar"`
@@ -123,51 +246,60 @@ func (t *Tradier) GetTodayTradePeriod(ctx context.Context) (
 		SetContext(ctx).
 		SetAuthToken(t.apiKey).
 		SetHeader("Accept", "application/json").
+		SetQueryParams(
+			map[string]string{
+				"month": strconv.Itoa(int(month)),
+				"year":  strconv.Itoa(year),
+			},
+		).
 		SetResult(body).
 		Get("/markets/calendar")
-	if err != nil {
-		return nil, xerrors.New(err.Error())
+	if err := account.CheckResponse("get trade calendar", resp, err); err != nil {
+		return nil, err
 	}
-	if resp.IsError() {
-		return nil, xerrors.Errorf(
-			"failed to get today trade period, status: %s, body: %s",
-			resp.Status(), resp.String(),
-		)
-	}
-	// get today's date in the format "YYYY-MM-DD" of New York timezone
-	today := time.Now().In(util.TZNewYork).Format("2006-01-02")
-	// find today's trade period
+	periods := make([]*datasourcev1.TradePeriod, 0, len(body.Calendar.Days.Day))
 	for _, day := range body.Calendar.Days.Day {
-		if day.Date == today {
-			if day.Status == "open" {
-				startTime, err := time.ParseInLocation(
-					"2006-04-02 15:04:05", today+" "+day.Open.Start+":00",
-					util.TZNewYork,
-				)
-				if err != nil {
-					return nil, xerrors.New(err.Error())
+		period := &datasourcev1.TradePeriod{
+			Date:        day.Date,
+			Description: day.Description,
+		}
+		if day.Status == "open" {
+			// parse "HH:MM" of the day in New York, an empty value means no such session
+			parse := func(hhmm string) (int64, error) {
+				if hhmm == "" {
+					return 0, nil
 				}
-				endTime, err := time.ParseInLocation(
-					"2006-04-02 15:04:05", today+" "+day.Open.End+":00",
-					util.TZNewYork,
+				at, err := time.ParseInLocation(
+					"2006-01-02 15:04", day.Date+" "+hhmm, util.TZNewYork,
 				)
 				if err != nil {
-					return nil, xerrors.New(err.Error())
+					return 0, account.MalformedError("get trade calendar", err)
 				}
-				return &datasourcev1.TradePeriod{
-					Date:    day.Date,
-					IsOpen:  true,
-					OpenAt:  startTime.UnixMilli(),
-					CloseAt: endTime.UnixMilli(),
-				}, nil
-			} else {
-				return &datasourcev1.TradePeriod{
-					Date: day.Date,
-				}, nil
+				return at.UnixMilli(), nil
+			}
+			period.IsOpen = true
+			if period.OpenAt, err = parse(day.Open.Start); err != nil {
+				return nil, err
+			}
+			if period.CloseAt, err = parse(day.Open.End); err != nil {
+				return nil, err
+			}
+			if period.PremarketOpenAt, err = parse(day.Premarket.Start); err != nil {
+				return nil, err
 			}
+			if period.PostmarketCloseAt, err = parse(day.Postmarket.End); err != nil {
+				return nil, err
+			}
+			period.IsEarlyClose = day.Open.End < "16:00"
 		}
+		periods = append(periods, period)
 	}
-	return nil, xerrors.Errorf("today's trade period not found, today: %s", today)
+	sort.Slice(
+		periods, func(i, j int) bool {
+			return periods[i].Date < periods[j].Date
+		},
+	)
+	return periods, nil
 }
 
 // GetOptionChains refer to https://documentation.tradier.com/brokerage-api/markets/get-options-chains
@@ -190,6 +322,12 @@ func (t *Tradier) GetOptionChains(
 				Ask            float64 `json:"ask"`
 				AskSize        int     `json:"asksize"`
 				AskDate        int64   `json:"ask_date"`
+				Last           float64 `json:"last"`
+				LastVolume     int64   `json:"last_volume"`
+				TradeDate      int64   `json:"trade_date"`
+				Change         float64 `json:"change"`
+				Volume         int64   `json:"volume"`
+				OpenInterest   int64   `json:"open_interest"`
 				Greeks         struct {
 					Delta     float64 `json:"delta"`
 					Gamma     float64 `json:"gamma"`
@@ -216,14 +354,8 @@ func (t *Tradier) GetOptionChains(
 		).
 		SetResult(body).
 		Get("/markets/options/chains")
-	if err != nil {
-		return nil, xerrors.New(err.Error())
-	}
-	if resp.IsError() {
-		return nil, xerrors.Errorf(
-			"failed to get option chains, status: %s, body: %s",
-			resp.Status(), resp.String(),
-		)
+	if err := account.CheckResponse("get option chains", resp, err); err != nil {
+		return nil, err
 	}
 	// Group options to chain by root_symbol
 	chains := make(map[string]*datasourcev1.Chain)
@@ -239,27 +371,35 @@ func (t *Tradier) GetOptionChains(
 		}
 		// Append option to chain
 		option := &datasourcev1.Option{
-			Symbol:  opt.Symbol,
-			Strike:  opt.Strike,
-			Bid:     opt.Bid,
-			BidSize: int32(opt.BidSize),
-			BidAt:   opt.BidDate,
-			Ask:     opt.Ask,
-			AskSize: int32(opt.AskSize),
-			AskAt:   opt.AskDate,
-			QuoteAt: time.Now().UnixMilli(),
-			Iv:      opt.Greeks.BidIV,
-			Delta:   opt.Greeks.Delta,
-			Gamma:   opt.Greeks.Gamma,
-			Vega:    opt.Greeks.Vega,
-			Theta:   opt.Greeks.Theta,
+			Symbol:       opt.Symbol,
+			Strike:       opt.Strike,
+			Bid:          opt.Bid,
+			BidSize:      int32(opt.BidSize),
+			BidAt:        opt.BidDate,
+			Ask:          opt.Ask,
+			AskSize:      int32(opt.AskSize),
+			AskAt:        opt.AskDate,
+			QuoteAt:      time.Now().UnixMilli(),
+			Iv:           opt.Greeks.BidIV,
+			Delta:        opt.Greeks.Delta,
+			Gamma:        opt.Greeks.Gamma,
+			Vega:         opt.Greeks.Vega,
+			Theta:        opt.Greeks.Theta,
+			Last:         opt.Last,
+			LastVolume:   opt.LastVolume,
+			LastAt:       opt.TradeDate,
+			Change:       opt.Change,
+			Volume:       opt.Volume,
+			OpenInterest: opt.OpenInterest,
+			MidIv:        opt.Greeks.MidIV,
+			AskIv:        opt.Greeks.AskIV,
 		}
 		// parse greeks updated at to unix milli
 		greeksUpdateAt, err := time.ParseInLocation(
 			"2006-01-02 15:04:05", opt.Greeks.UpdatedAt, util.TZNewYork,
 		)
 		if err != nil {
-			return nil, xerrors.New(err.Error())
+			return nil, account.MalformedError("get option chains", err)
 		}
 		option.GreeksUpdatedAt = greeksUpdateAt.UnixMilli()
 		if opt.OptionType == "call" {
@@ -275,6 +415,7 @@ func (t *Tradier) GetOptionChains(
 		account.SortByStrikePrice(chain)
 		rets = append(rets, chain)
 	}
+	t.applyLiveQuotes(rets)
 	return rets, nil
 }
 
@@ -299,14 +440,8 @@ func (t *Tradier) GetOptionExpirations(
 		).
 		SetResult(body).
 		Get("/markets/options/expirations")
-	if err != nil {
-		return nil, xerrors.New(err.Error())
-	}
-	if resp.IsError() {
-		return nil, xerrors.Errorf(
-			"failed to get option expirations, status: %s, body: %s",
-			resp.Status(), resp.String(),
-		)
+	if err := account.CheckResponse("get option expirations", resp, err); err != nil {
+		return nil, err
 	}
 	// sort expirations by date
 	sort.Strings(body.Expirations.Date)
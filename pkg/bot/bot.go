@@ -1,11 +1,86 @@
 package bot
 
-import botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+import (
+	"github.com/ppaanngggg/option-bot/pkg/datasource"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+)
 
 type Bot struct {
 	ID              string         `json:"id"`
 	Name            string         `json:"name"`
+	AccountID       string         `json:"account_id"`
+	DataAccountID   string         `json:"data_account_id,omitempty"` // the global data source if empty
 	EnableAutoOpen  bool           `json:"enable_auto_open"`
 	EnableAutoClose bool           `json:"enable_auto_close"`
 	Setting         *botv1.Setting `json:"setting"`
+	Status          *botv1.Status  `json:"status"`
+}
+
+// dataSource returns the data source of the bot, a nil bot uses the global one
+func dataSource(bot *Bot) (*datasource.DataSource, error) {
+	if bot == nil {
+		return datasource.Global()
+	}
+	return datasource.ForAccount(bot.DataAccountID)
+}
+
+// positionDataSource returns the data source of the bot opening the position,
+// positions of deleted bots use the global one
+func positionDataSource(position *botv1.Position) (*datasource.DataSource, error) {
+	bot, ok, err := Bots.store.Get(position.BotId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		bot = nil
+	}
+	return dataSource(bot)
+}
+
+var Bots = &BotStore{
+	store: util.NewFileStore[*Bot]("bots"),
+}
+
+// BotStore keeps the bots, bots are shared with callers, so update them by UpdateStatus
+type BotStore struct {
+	store *util.FileStore[*Bot]
+}
+
+func (s *BotStore) Get(id string) (*Bot, error) {
+	bot, ok, err := s.store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, xerrors.Errorf("bot not found, id: %s", id)
+	}
+	return bot, nil
+}
+
+// List returns the bots matching the filter, a nil filter matches all
+func (s *BotStore) List(filter func(b *Bot) bool) ([]*Bot, error) {
+	return s.store.List(filter)
+}
+
+func (s *BotStore) Save(bot *Bot) error {
+	return s.store.Put(bot.ID, bot)
+}
+
+// UpdateStatus applies update to a copy of the bot's status and saves it
+func (s *BotStore) UpdateStatus(id string, update func(status *botv1.Status)) error {
+	bot, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+	updated := *bot
+	if bot.Status != nil {
+		updated.Status = proto.Clone(bot.Status).(*botv1.Status)
+	} else {
+		updated.Status = &botv1.Status{}
+	}
+	update(updated.Status)
+	return s.Save(&updated)
 }
@@ -2,24 +2,27 @@ package bot
 
 import (
 	"testing"
+
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	"github.com/stretchr/testify/assert"
 )
 
 func Test_Bot(t *testing.T) {
 	bot := Bot{
 		Name: "unit_test",
-		Setting: Setting{
-			Legs: []Leg{
+		Setting: &botv1.Setting{
+			Underlying: "SPX",
+			Legs: []*botv1.Leg{
 				{
-					Underlying: "SPX",
-					Action:     BUY,
-					Type:       CALL,
+					Action:     botv1.Action_ACTION_LONG,
+					OptionType: botv1.OptionType_OPTION_TYPE_CALL,
 					Quantity:   1,
-					Strike:     Strike{},
+					Strike:     &botv1.Strike{},
 				},
 			},
 		},
 		EnableAutoOpen:  true,
 		EnableAutoClose: true,
 	}
-	println(bot)
+	assert.Equal(t, "SPX", bot.Setting.Underlying)
 }
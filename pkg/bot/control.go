@@ -0,0 +1,236 @@
+package bot
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrHalted is returned when opening a position while trading is halted
+var ErrHalted = xerrors.New("trading is halted")
+
+// stateKey is the only record of the trading state store
+const stateKey = "state"
+
+var Control = &control{
+	states: util.NewFileStore[*botv1.TradingState]("trading_state"),
+	events: util.NewAppendLog[*botv1.TradingControlEvent]("trading_control"),
+	logger: util.DefaultLogger.With(slog.F("bot", "control")),
+}
+
+// flattenParallelism is how many positions a flatten closes at once
+const flattenParallelism = 8
+
+// control is the kill switch of all bots, a halt stops opening positions until resumed,
+// closing positions is always allowed
+type control struct {
+	states *util.FileStore[*botv1.TradingState]
+	events *util.AppendLog[*botv1.TradingControlEvent]
+	logger slog.Logger
+
+	// the flatten of the last halt, kept in memory
+	mu      sync.Mutex
+	flatten *botv1.FlattenProgress
+}
+
+func (c *control) State() (*botv1.TradingState, error) {
+	state, ok, err := c.states.Get(stateKey)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &botv1.TradingState{}, nil
+	}
+	return state, nil
+}
+
+// Halt stops opening positions, and closes all open positions in the background if flatten is set,
+// the progress of the flatten is returned and kept until the next one
+func (c *control) Halt(
+	ctx context.Context, operator string, reason string, flatten botv1.FlattenType,
+) (*botv1.TradingState, *botv1.TradingControlEvent, *botv1.FlattenProgress, error) {
+	now := time.Now()
+	state := &botv1.TradingState{
+		Halted:    true,
+		UpdatedAt: now.UnixMilli(),
+		Operator:  operator,
+		Reason:    reason,
+	}
+	// persist before flattening, so that no bot opens a position meanwhile
+	if err := c.states.Put(stateKey, state); err != nil {
+		return nil, nil, nil, err
+	}
+	c.logger.Warn(
+		ctx, "trading halted", slog.F("operator", operator), slog.F("reason", reason),
+		slog.F("flatten", flatten.String()),
+	)
+	event := &botv1.TradingControlEvent{
+		CreatedAt: now.UnixMilli(),
+		Action:    botv1.TradingAction_TRADING_ACTION_HALT,
+		Flatten:   flatten,
+		Operator:  operator,
+		Reason:    reason,
+	}
+	if err := c.events.Append(event); err != nil {
+		return nil, nil, nil, err
+	}
+	if flatten == botv1.FlattenType_FLATTEN_TYPE_UNSPECIFIED {
+		return state, event, nil, nil
+	}
+	progress, err := c.startFlatten(ctx, operator, reason, flatten, now)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return state, event, progress, nil
+}
+
+// Flatten returns a copy of the progress of the last flatten, nil if none since the server started
+func (c *control) Flatten() *botv1.FlattenProgress {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.flatten == nil {
+		return nil
+	}
+	return proto.Clone(c.flatten).(*botv1.FlattenProgress)
+}
+
+// startFlatten closes all open positions in the background, detached from ctx which is usually
+// the request, a running flatten is returned instead of starting another one
+func (c *control) startFlatten(
+	ctx context.Context, operator string, reason string, flatten botv1.FlattenType, now time.Time,
+) (*botv1.FlattenProgress, error) {
+	// the halt is persisted, so the orders placed after are blocked, wait for the ones placed before
+	Executor.waitOpening()
+	c.mu.Lock()
+	if c.flatten != nil && c.flatten.FinishedAt == 0 {
+		c.mu.Unlock()
+		c.logger.Warn(ctx, "flatten is already running")
+		return c.Flatten(), nil
+	}
+	positions, err := Positions.ListOpen(nil)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	c.flatten = &botv1.FlattenProgress{Flatten: flatten, StartedAt: now.UnixMilli()}
+	for _, position := range positions {
+		c.flatten.PendingPositionIds = append(c.flatten.PendingPositionIds, position.Id)
+	}
+	c.mu.Unlock()
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		opts := CloseOptions{
+			IsMarket: flatten == botv1.FlattenType_FLATTEN_TYPE_MARKET, Reason: "flatten: " + reason,
+		}
+		sem := make(chan struct{}, flattenParallelism)
+		wg := sync.WaitGroup{}
+		for _, position := range positions {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(position *botv1.Position) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				_, err := Executor.Close(ctx, position, opts, time.Now())
+				if xerrors.Is(err, ErrNotOpen) {
+					// closed meanwhile by an exit or the expiry
+					err = nil
+				}
+				if err != nil {
+					c.logger.Error(
+						ctx, "failed to flatten position", slog.F("position_id", position.Id),
+						slog.Error(err),
+					)
+				}
+				c.closed(position.Id, err)
+			}(position)
+		}
+		wg.Wait()
+
+		c.mu.Lock()
+		c.flatten.FinishedAt = time.Now().UnixMilli()
+		event := &botv1.TradingControlEvent{
+			CreatedAt:         c.flatten.FinishedAt,
+			Action:            botv1.TradingAction_TRADING_ACTION_FLATTEN,
+			Flatten:           flatten,
+			Operator:          operator,
+			Reason:            reason,
+			ClosedPositionIds: c.flatten.ClosedPositionIds,
+			Errors:            c.flatten.Errors,
+		}
+		c.mu.Unlock()
+		c.logger.Warn(
+			ctx, "flatten finished", slog.F("closed", len(event.ClosedPositionIds)),
+			slog.F("errors", len(event.Errors)),
+		)
+		if err := c.events.Append(event); err != nil {
+			c.logger.Error(ctx, "failed to save flatten event", slog.Error(err))
+		}
+	}()
+	return c.Flatten(), nil
+}
+
+// closed moves the position from pending to closed or errors
+func (c *control) closed(positionID string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.flatten.PendingPositionIds = slices.DeleteFunc(
+		c.flatten.PendingPositionIds, func(id string) bool {
+			return id == positionID
+		},
+	)
+	if err != nil {
+		c.flatten.Errors = append(c.flatten.Errors, positionID+": "+err.Error())
+	} else {
+		c.flatten.ClosedPositionIds = append(c.flatten.ClosedPositionIds, positionID)
+	}
+}
+
+func (c *control) Resume(
+	ctx context.Context, operator string, reason string,
+) (*botv1.TradingState, *botv1.TradingControlEvent, error) {
+	now := time.Now()
+	state := &botv1.TradingState{
+		UpdatedAt: now.UnixMilli(),
+		Operator:  operator,
+		Reason:    reason,
+	}
+	if err := c.states.Put(stateKey, state); err != nil {
+		return nil, nil, err
+	}
+	c.logger.Info(ctx, "trading resumed", slog.F("operator", operator), slog.F("reason", reason))
+	event := &botv1.TradingControlEvent{
+		CreatedAt: now.UnixMilli(),
+		Action:    botv1.TradingAction_TRADING_ACTION_RESUME,
+		Operator:  operator,
+		Reason:    reason,
+	}
+	if err := c.events.Append(event); err != nil {
+		return nil, nil, err
+	}
+	return state, event, nil
+}
+
+// Events returns the latest audit events first, a non-positive limit returns all
+func (c *control) Events(limit int) ([]*botv1.TradingControlEvent, error) {
+	events, err := c.events.Read(nil)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(events) > limit {
+		events = events[len(events)-limit:]
+	}
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return events, nil
+}
@@ -0,0 +1,122 @@
+package bot
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestControl(t *testing.T) {
+	util.Conf.Storage.Dir = t.TempDir()
+	newControl := func() *control {
+		return &control{
+			states: util.NewFileStore[*botv1.TradingState]("trading_state"),
+			events: util.NewAppendLog[*botv1.TradingControlEvent]("trading_control"),
+			logger: slog.Make(),
+		}
+	}
+	c := newControl()
+	state, err := c.State()
+	assert.NoError(t, err)
+	assert.False(t, state.Halted)
+
+	_, event, _, err := c.Halt(
+		context.Background(), "alice", "broker outage", botv1.FlattenType_FLATTEN_TYPE_UNSPECIFIED,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, botv1.TradingAction_TRADING_ACTION_HALT, event.Action)
+
+	// the halt survives a restart
+	c = newControl()
+	state, err = c.State()
+	assert.NoError(t, err)
+	assert.True(t, state.Halted)
+	assert.Equal(t, "alice", state.Operator)
+
+	_, _, err = c.Resume(context.Background(), "bob", "resolved")
+	assert.NoError(t, err)
+	state, err = c.State()
+	assert.NoError(t, err)
+	assert.False(t, state.Halted)
+
+	events, err := c.Events(10)
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "bob", events[0].Operator)
+	assert.Equal(t, "broker outage", events[1].Reason)
+}
+
+func TestControl_Flatten(t *testing.T) {
+	broker := &filledBroker{delay: 10 * time.Millisecond}
+	useBroker(t, broker)
+	c := &control{
+		states: util.NewFileStore[*botv1.TradingState]("trading_state"),
+		events: util.NewAppendLog[*botv1.TradingControlEvent]("trading_control"),
+		logger: slog.Make(),
+	}
+	for i := 0; i < 3; i++ {
+		openPosition(t, "p"+strconv.Itoa(i))
+	}
+
+	// the request ctx is canceled once halted, the flatten goes on
+	ctx, cancel := context.WithCancel(context.Background())
+	_, _, progress, err := c.Halt(ctx, "alice", "broker outage", botv1.FlattenType_FLATTEN_TYPE_MARKET)
+	cancel()
+	assert.NoError(t, err)
+	assert.Len(t, progress.PendingPositionIds, 3)
+
+	assert.Eventually(
+		t, func() bool {
+			return c.Flatten().FinishedAt > 0
+		}, time.Second, 5*time.Millisecond,
+	)
+	progress = c.Flatten()
+	assert.Empty(t, progress.PendingPositionIds)
+	assert.Len(t, progress.ClosedPositionIds, 3)
+	assert.Empty(t, progress.Errors)
+	open, err := Positions.ListOpen(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, open)
+
+	events, err := c.Events(0)
+	assert.NoError(t, err)
+	assert.Equal(t, botv1.TradingAction_TRADING_ACTION_FLATTEN, events[0].Action)
+	assert.Len(t, events[0].ClosedPositionIds, 3)
+}
+
+func TestControl_Flatten_WaitsOpening(t *testing.T) {
+	broker := &filledBroker{}
+	useBroker(t, broker)
+	c := &control{
+		states: util.NewFileStore[*botv1.TradingState]("trading_state"),
+		events: util.NewAppendLog[*botv1.TradingControlEvent]("trading_control"),
+		logger: slog.Make(),
+	}
+
+	// an open holds the account lock while its order fills
+	unlock := Executor.lock("acc")
+	halted := make(chan *botv1.FlattenProgress, 1)
+	go func() {
+		_, _, progress, err := c.Halt(context.Background(), "alice", "outage", botv1.FlattenType_FLATTEN_TYPE_MARKET)
+		assert.NoError(t, err)
+		halted <- progress
+	}()
+	time.Sleep(10 * time.Millisecond)
+	openPosition(t, "p1")
+	unlock()
+
+	// the flatten sees the position opened meanwhile
+	progress := <-halted
+	assert.Equal(t, []string{"p1"}, progress.PendingPositionIds)
+	assert.Eventually(
+		t, func() bool {
+			return c.Flatten().FinishedAt > 0
+		}, time.Second, 5*time.Millisecond,
+	)
+}
@@ -0,0 +1,275 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/calendar"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+)
+
+// checkEntry checks the entry conditions of a due bot, with the reason if they don't pass
+func checkEntry(ctx context.Context, bot *Bot, now time.Time) (bool, string) {
+	entry := bot.Setting.GetEntry()
+	now = now.In(util.TZNewYork)
+	if weekdays := entry.GetWeekdays(); weekdays != nil {
+		chosen := map[time.Weekday]bool{
+			time.Monday:    weekdays.Monday,
+			time.Tuesday:   weekdays.Tuesday,
+			time.Wednesday: weekdays.Wednesday,
+			time.Thursday:  weekdays.Thursday,
+			time.Friday:    weekdays.Friday,
+		}
+		if !chosen[now.Weekday()] {
+			return false, now.Weekday().String() + " is not an entry weekday"
+		}
+	}
+	// the scheduler doesn't even try once the bot holds its max positions
+	if limit := bot.Setting.GetMaxOpenPositions(); limit > 0 {
+		open, err := Positions.ListOpen(
+			func(p *botv1.Position) bool {
+				return p.BotId == bot.ID
+			},
+		)
+		if err != nil {
+			return false, "failed to list positions: " + err.Error()
+		}
+		if len(open) >= int(limit) {
+			return false, fmt.Sprintf("bot has %d open positions, limit %d", len(open), limit)
+		}
+	}
+	if !hasMarketConditions(entry) {
+		return true, ""
+	}
+	ds, err := dataSource(bot)
+	if err != nil {
+		return false, "no market data: " + err.Error()
+	}
+	if reason, err := checkMarketConditions(ctx, ds, bot.Setting.Underlying, entry, now); err != nil {
+		return false, "failed to check conditions: " + err.Error()
+	} else if reason != "" {
+		return false, reason
+	}
+	return true, ""
+}
+
+func hasIVConditions(entry *botv1.Entry) bool {
+	return entry.GetIvRank() != nil || entry.GetIvPercentile() != nil
+}
+
+func hasMarketConditions(entry *botv1.Entry) bool {
+	return entry.GetVix() != nil || entry.GetIvRank() != nil || entry.GetIvPercentile() != nil ||
+		entry.GetDayChange() != nil || entry.GetGap() != nil
+}
+
+// checkMarketConditions returns the reason of the first failed condition, empty if all pass
+func checkMarketConditions(
+	ctx context.Context, market account.Market, underlying string, entry *botv1.Entry, now time.Time,
+) (string, error) {
+	vixSymbol := util.Conf.Bot.VIXSymbol
+	symbols := []string{underlying}
+	if entry.GetVix() != nil {
+		symbols = append(symbols, vixSymbol)
+	}
+	quotes, err := market.GetQuotes(ctx, symbols)
+	if err != nil {
+		return "", err
+	}
+	bySymbol := make(map[string]*datasourcev1.Quote, len(quotes))
+	for _, quote := range quotes {
+		bySymbol[quote.Symbol] = quote
+	}
+	quote, ok := bySymbol[underlying]
+	if !ok {
+		return "", xerrors.Errorf("no quote of %s", underlying)
+	}
+
+	if entry.GetVix() != nil {
+		vix, ok := bySymbol[vixSymbol]
+		if !ok {
+			return "", xerrors.Errorf("no quote of %s", vixSymbol)
+		}
+		if reason := checkCondition("vix", vix.Last, entry.Vix); reason != "" {
+			return reason, nil
+		}
+	}
+	if entry.GetDayChange() != nil || entry.GetGap() != nil {
+		if quote.PrevClose <= 0 {
+			return "", xerrors.Errorf("no previous close of %s", underlying)
+		}
+		change := (quotePrice(quote) - quote.PrevClose) / quote.PrevClose * 100
+		if reason := checkCondition("day change %", change, entry.DayChange); reason != "" {
+			return reason, nil
+		}
+		gap := (quote.Open - quote.PrevClose) / quote.PrevClose * 100
+		if reason := checkCondition("gap %", gap, entry.Gap); reason != "" {
+			return reason, nil
+		}
+	}
+	if hasIVConditions(entry) {
+		iv, err := atmIV(ctx, market, underlying, quotePrice(quote), now)
+		if err != nil {
+			return "", err
+		}
+		if err := IVHistory.Record(underlying, now, iv); err != nil {
+			return "", err
+		}
+		rank, percentile, err := IVHistory.Rank(underlying, now, iv)
+		if err != nil {
+			return "", err
+		}
+		if reason := checkCondition("iv rank", rank, entry.IvRank); reason != "" {
+			return reason, nil
+		}
+		if reason := checkCondition("iv percentile", percentile, entry.IvPercentile); reason != "" {
+			return reason, nil
+		}
+	}
+	return "", nil
+}
+
+// checkCondition returns why the value fails the condition, empty if it passes or the condition is absent
+func checkCondition(name string, value float64, condition *botv1.Condition) string {
+	if condition == nil {
+		return ""
+	}
+	if condition.Min != nil && value < *condition.Min {
+		return fmt.Sprintf("%s %.2f is below %.2f", name, value, *condition.Min)
+	}
+	if condition.Max != nil && value > *condition.Max {
+		return fmt.Sprintf("%s %.2f is above %.2f", name, value, *condition.Max)
+	}
+	return ""
+}
+
+// quotePrice is the last price, or the mid price if it never traded today
+func quotePrice(quote *datasourcev1.Quote) float64 {
+	if quote.Last <= 0 && quote.Bid > 0 && quote.Ask > 0 {
+		return (quote.Bid + quote.Ask) / 2
+	}
+	return quote.Last
+}
+
+// atmIV returns the average IV of the call and put nearest to the price,
+// at the expiration nearest to the target DTE
+func atmIV(
+	ctx context.Context, market account.Market, underlying string, price float64, now time.Time,
+) (float64, error) {
+	expirations, err := market.GetOptionExpirations(ctx, underlying)
+	if err != nil {
+		return 0, err
+	}
+	expiration, err := chooseExpiration(
+		expirations,
+		&botv1.DTE{Match: botv1.Match_MATCH_NEAREST, Dte: int32(util.Conf.Bot.IVTargetDTE)},
+		now,
+	)
+	if err != nil {
+		return 0, err
+	}
+	chains, err := market.GetOptionChains(ctx, underlying, expiration)
+	if err != nil {
+		return 0, err
+	}
+	chain := pickChain(chains)
+	if chain == nil {
+		return 0, xerrors.Errorf("no option chain of %s at %s", underlying, expiration)
+	}
+	var sum float64
+	var count int
+	for _, options := range [][]*datasourcev1.Option{chain.Calls, chain.Puts} {
+		var nearest *datasourcev1.Option
+		for _, option := range options {
+			if nearest == nil || math.Abs(option.Strike-price) < math.Abs(nearest.Strike-price) {
+				nearest = option
+			}
+		}
+		if nearest != nil && nearest.Iv > 0 {
+			sum += nearest.Iv
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, xerrors.Errorf("no at-the-money IV of %s at %s", underlying, expiration)
+	}
+	return sum / float64(count), nil
+}
+
+// sampleIV records today's at-the-money IV of the underlying
+func sampleIV(ctx context.Context, market account.Market, underlying string, now time.Time) error {
+	quotes, err := market.GetQuotes(ctx, []string{underlying})
+	if err != nil {
+		return err
+	}
+	for _, quote := range quotes {
+		if quote.Symbol != underlying {
+			continue
+		}
+		iv, err := atmIV(ctx, market, underlying, quotePrice(quote), now)
+		if err != nil {
+			return err
+		}
+		return IVHistory.Record(underlying, now, iv)
+	}
+	return xerrors.Errorf("no quote of %s", underlying)
+}
+
+// IVHistory keeps one at-the-money IV sample per underlying per day, the last of the day wins
+var IVHistory = &ivHistory{
+	store: util.NewFileStore[*ivSample]("iv_history"),
+}
+
+type ivSample struct {
+	Underlying string  `json:"underlying"`
+	Date       string  `json:"date"` // yyyy-mm-dd
+	IV         float64 `json:"iv"`
+}
+
+type ivHistory struct {
+	store *util.FileStore[*ivSample]
+}
+
+func (h *ivHistory) Record(underlying string, now time.Time, iv float64) error {
+	sample := &ivSample{Underlying: underlying, Date: calendar.Date(now), IV: iv}
+	return h.store.Put(sample.Underlying+"/"+sample.Date, sample)
+}
+
+// Rank returns the IV rank and percentile in percent of iv among the samples of the lookback before today
+func (h *ivHistory) Rank(underlying string, now time.Time, iv float64) (float64, float64, error) {
+	today := calendar.Date(now)
+	from := calendar.Date(now.AddDate(0, 0, -util.Conf.Bot.IVLookbackDays))
+	samples, err := h.store.List(
+		func(s *ivSample) bool {
+			return s.Underlying == underlying && s.Date >= from && s.Date < today
+		},
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(samples) < util.Conf.Bot.IVMinSamples {
+		return 0, 0, xerrors.Errorf(
+			"only %d IV samples of %s, at least %d required",
+			len(samples), underlying, util.Conf.Bot.IVMinSamples,
+		)
+	}
+	ivs := make([]float64, 0, len(samples))
+	for _, s := range samples {
+		ivs = append(ivs, s.IV)
+	}
+	sort.Float64s(ivs)
+	lo, hi := ivs[0], ivs[len(ivs)-1]
+	rank := 0.0
+	if hi > lo {
+		rank = math.Max(0, math.Min(100, (iv-lo)/(hi-lo)*100))
+	}
+	lower := sort.SearchFloat64s(ivs, iv)
+	percentile := float64(lower) / float64(len(ivs)) * 100
+	return rank, percentile, nil
+}
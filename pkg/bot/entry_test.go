@@ -0,0 +1,82 @@
+package bot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCheckMarketConditions(t *testing.T) {
+	now := time.Date(2024, 1, 16, 10, 0, 0, 0, util.TZNewYork)
+	check := func(entry *botv1.Entry) string {
+		reason, err := checkMarketConditions(context.Background(), &fakeMarket{}, "SPX", entry, now)
+		assert.NoError(t, err)
+		return reason
+	}
+	// VIX is 18, SPX is down 1.04% on the day after a gap up of 0.21%
+	assert.Empty(t, check(&botv1.Entry{Vix: &botv1.Condition{Min: proto.Float64(15), Max: proto.Float64(25)}}))
+	assert.Equal(
+		t, "vix 18.00 is below 20.00",
+		check(&botv1.Entry{Vix: &botv1.Condition{Min: proto.Float64(20)}}),
+	)
+	assert.Empty(t, check(&botv1.Entry{DayChange: &botv1.Condition{Max: proto.Float64(-1)}}))
+	assert.Equal(
+		t, "gap % 0.21 is above 0.10",
+		check(&botv1.Entry{Gap: &botv1.Condition{Max: proto.Float64(0.1)}}),
+	)
+}
+
+func TestIVHistory_Rank(t *testing.T) {
+	util.Conf.Storage.Dir = t.TempDir()
+	h := &ivHistory{store: util.NewFileStore[*ivSample]("iv_history")}
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, util.TZNewYork)
+	_, _, err := h.Rank("SPX", now, 0.2)
+	assert.Error(t, err)
+
+	// 0.10, 0.11, ..., 0.29 in the 20 days before
+	for i := 0; i < 20; i++ {
+		assert.NoError(t, h.Record("SPX", now.AddDate(0, 0, i-20), 0.1+float64(i)*0.01))
+	}
+	// today's sample is excluded
+	assert.NoError(t, h.Record("SPX", now, 1))
+	rank, percentile, err := h.Rank("SPX", now, 0.2)
+	assert.NoError(t, err)
+	assert.InDelta(t, 52.63, rank, 0.01)
+	assert.InDelta(t, 50, percentile, 1e-9)
+}
+
+// ivMarket quotes an IV of 0.2 for the at-the-money options
+type ivMarket struct {
+	fakeMarket
+}
+
+func (m *ivMarket) GetOptionChains(
+	ctx context.Context, underlying string, expiration string,
+) ([]*datasourcev1.Chain, error) {
+	chains, err := m.fakeMarket.GetOptionChains(ctx, underlying, expiration)
+	for _, chain := range chains {
+		for _, option := range chain.Puts {
+			option.Iv = 0.2
+		}
+	}
+	return chains, err
+}
+
+func TestSampleIV(t *testing.T) {
+	util.Conf.Storage.Dir = t.TempDir()
+	IVHistory = &ivHistory{store: util.NewFileStore[*ivSample]("iv_history")}
+	now := time.Date(2024, 1, 16, 15, 50, 0, 0, util.TZNewYork)
+	assert.NoError(t, sampleIV(context.Background(), &ivMarket{}, "SPX", now))
+	sample, ok, err := IVHistory.store.Get("SPX/2024-01-16")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 0.2, sample.IV)
+
+	assert.Error(t, sampleIV(context.Background(), &ivMarket{}, "QQQ", now))
+}
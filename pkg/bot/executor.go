@@ -0,0 +1,551 @@
+package bot
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrVetoed is returned when the risk checks block an order
+var ErrVetoed = xerrors.New("order vetoed by risk checks")
+
+// ErrNotOpen is returned when closing or rolling a position which is no longer open,
+// e.g. closed meanwhile by another exit
+var ErrNotOpen = xerrors.New("position is not open")
+
+var Executor = &executor{
+	open:   account.Open,
+	logger: util.DefaultLogger.With(slog.F("bot", "executor")),
+}
+
+// executor places the orders of bots, opening orders of one account are placed one by one,
+// so that concurrent bots can't pass the risk checks together, and a position is closed or rolled
+// by one order at a time
+type executor struct {
+	// opens the client of an account, replaced by tests
+	open   func(id string) (*account.Client, error)
+	logger slog.Logger
+	locks  sync.Map // account id or positionLockKey -> *sync.Mutex
+}
+
+func (e *executor) lock(key string) func() {
+	mu, _ := e.locks.LoadOrStore(key, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// waitOpening waits for the opening and rolling orders holding an account lock to finish,
+// so that a flatten listing the positions afterwards sees their positions
+func (e *executor) waitOpening() {
+	e.locks.Range(
+		func(key, mu any) bool {
+			if !strings.HasPrefix(key.(string), positionLockKey("")) {
+				mu.(*sync.Mutex).Lock()
+				mu.(*sync.Mutex).Unlock()
+			}
+			return true
+		},
+	)
+}
+
+// checkHalted returns ErrHalted if trading is halted
+func checkHalted() error {
+	state, err := Control.State()
+	if err != nil {
+		return err
+	}
+	if state.Halted {
+		return xerrors.Errorf("%w, reason: %s", ErrHalted, state.Reason)
+	}
+	return nil
+}
+
+func positionLockKey(id string) string {
+	return "position/" + id
+}
+
+// lockOpen locks the position and re-loads it, since the flatten, the exit engine, the expiry processor
+// and manual closes may close it concurrently, the lock is released on error
+func (e *executor) lockOpen(position *botv1.Position) (*botv1.Position, func(), error) {
+	unlock := e.lock(positionLockKey(position.Id))
+	current, err := Positions.Get(position.Id)
+	if err != nil {
+		unlock()
+		return nil, nil, err
+	}
+	if current.Status != botv1.PositionStatus_POSITION_STATUS_OPEN {
+		unlock()
+		return nil, nil, xerrors.Errorf("%w, id: %s", ErrNotOpen, position.Id)
+	}
+	return current, unlock, nil
+}
+
+// update applies update to a copy of the position if it's still open, and saves it
+func (e *executor) update(position *botv1.Position, update func(p *botv1.Position)) (*botv1.Position, error) {
+	return e.replace(
+		position, func(current *botv1.Position) (*botv1.Position, error) {
+			updated := proto.Clone(current).(*botv1.Position)
+			update(updated)
+			return updated, nil
+		},
+	)
+}
+
+// replace saves the position built by build from the position if it's still open
+func (e *executor) replace(
+	position *botv1.Position, build func(current *botv1.Position) (*botv1.Position, error),
+) (*botv1.Position, error) {
+	current, unlock, err := e.lockOpen(position)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	replaced, err := build(current)
+	if err != nil {
+		return nil, err
+	}
+	if err := Positions.Save(replaced); err != nil {
+		return nil, err
+	}
+	return replaced, nil
+}
+
+// Open resolves the setting of the bot, checks the risk and opens a position by a limit order at mid,
+// the decisions are recorded in run if it's not nil
+func (e *executor) Open(
+	ctx context.Context, bot *Bot, now time.Time, run *botv1.Run,
+) (*botv1.Position, error) {
+	if run == nil {
+		run = &botv1.Run{}
+	}
+	logger := e.logger.With(slog.F("bot_id", bot.ID), slog.F("account_id", bot.AccountID))
+	if err := checkHalted(); err != nil {
+		return nil, err
+	}
+	client, err := e.open(bot.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := dataSource(bot)
+	if err != nil {
+		return nil, err
+	}
+	proposal, err := Resolve(ctx, ds, bot.Setting, now)
+	if err != nil {
+		return nil, err
+	}
+	recordProposal(run, proposal)
+
+	unlock := e.lock(client.ID)
+	defer unlock()
+	violations, err := CheckRisk(ctx, bot, client, proposal, now)
+	if err != nil {
+		return nil, err
+	}
+	run.RiskChecked = true
+	run.RiskViolations = violations
+	if err := Bots.UpdateStatus(
+		bot.ID, func(status *botv1.Status) {
+			status.RiskViolations = violations
+			status.RiskCheckedAt = now.UnixMilli()
+		},
+	); err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		logger.Warn(ctx, "order vetoed", slog.F("violations", violations))
+		return nil, xerrors.Errorf("%w: %s", ErrVetoed, strings.Join(violations, "; "))
+	}
+	// halted while resolving or waiting for the lock, a flatten waits for the lock before listing positions
+	if err := checkHalted(); err != nil {
+		return nil, err
+	}
+
+	order := proposal.Order(util.Conf.Bot.PriceTick)
+	orderID, err := client.Broker.PlaceOrder(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	run.OrderIds = append(run.OrderIds, orderID)
+	logger.Info(
+		ctx, "order placed", slog.F("order_id", orderID), slog.F("price", order.Price),
+		slog.F("size", proposal.Size),
+	)
+	result, err := e.waitFill(ctx, client.Broker, orderID)
+	if err != nil {
+		return nil, err
+	}
+	// a partial fill opens a smaller position
+	if units := e.filledUnits(ctx, order, proposal.Size, result); units < proposal.Size {
+		if units == 0 {
+			return nil, xerrors.Errorf("order %s is not filled by a whole unit", orderID)
+		}
+		proposal.MaxLoss = proposal.MaxLoss / float64(proposal.Size) * float64(units)
+		proposal.Size = units
+	}
+	position := newPosition(bot, proposal, result, now)
+	if err := Positions.Save(position); err != nil {
+		return nil, err
+	}
+	run.PositionId = position.Id
+	logger.Info(
+		ctx, "position opened", slog.F("position_id", position.Id),
+		slog.F("open_price", position.OpenPrice),
+	)
+	return position, nil
+}
+
+// waitFill polls the order until it's done, an order not filled in time is canceled.
+// A partially filled order is returned without error, its filled contracts are live at the broker,
+// so the caller records them, see filledUnits.
+func (e *executor) waitFill(
+	ctx context.Context, broker account.Broker, orderID string,
+) (*account.OrderResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, util.Conf.Bot.FillTimeout)
+	defer cancel()
+	ticker := time.NewTicker(util.Conf.Bot.FillPollInterval)
+	defer ticker.Stop()
+	for {
+		result, err := broker.GetOrder(ctx, orderID)
+		if err == nil && result.Status.IsDone() {
+			if result.Status != account.OrderStatusFilled && !partiallyFilled(result) {
+				return nil, xerrors.Errorf("order %s is not filled, status: %d", orderID, result.Status)
+			}
+			return result, nil
+		}
+		select {
+		case <-ctx.Done():
+			// cancel with a fresh context, and check once more in case it filled meanwhile
+			cleanup, cleanupCancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cleanupCancel()
+			if err := broker.CancelOrder(cleanup, orderID); err != nil {
+				e.logger.Error(cleanup, "failed to cancel order", slog.F("order_id", orderID), slog.Error(err))
+			}
+			result, err := broker.GetOrder(cleanup, orderID)
+			if err == nil && (result.Status == account.OrderStatusFilled || partiallyFilled(result)) {
+				return result, nil
+			}
+			return nil, xerrors.Errorf("order %s is not filled in %s", orderID, util.Conf.Bot.FillTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+func partiallyFilled(result *account.OrderResult) bool {
+	for _, quantity := range result.LegFilledQuantities {
+		if quantity > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// filledUnits returns how many of the units of the order are filled, the contracts of a leg beyond
+// the filled units are logged since no position holds them
+func (e *executor) filledUnits(
+	ctx context.Context, order *account.Order, size int32, result *account.OrderResult,
+) int32 {
+	if result.Status == account.OrderStatusFilled {
+		return size
+	}
+	units := size
+	for _, leg := range order.Legs {
+		perUnit := float64(leg.Quantity / size)
+		units = min(units, int32(result.LegFilledQuantities[leg.Symbol]/perUnit))
+	}
+	e.logger.Error(
+		ctx, "order is partially filled", slog.F("order_id", result.ID), slog.F("filled_units", units),
+		slog.F("size", size), slog.F("leg_filled_quantities", result.LegFilledQuantities),
+	)
+	for _, leg := range order.Legs {
+		perUnit := float64(leg.Quantity / size)
+		if extra := result.LegFilledQuantities[leg.Symbol] - perUnit*float64(units); extra > 0 {
+			e.logger.Error(
+				ctx, "filled contracts not held by any position", slog.F("order_id", result.ID),
+				slog.F("symbol", leg.Symbol), slog.F("quantity", extra),
+			)
+		}
+	}
+	return units
+}
+
+func newPosition(
+	bot *Bot, proposal *Proposal, result *account.OrderResult, now time.Time,
+) *botv1.Position {
+	openPrice := proposal.Price
+	if result.FillPrice != 0 {
+		openPrice = result.FillPrice
+	}
+	position := &botv1.Position{
+		Id:          util.NewID(),
+		BotId:       bot.ID,
+		AccountId:   bot.AccountID,
+		Underlying:  proposal.Underlying,
+		Status:      botv1.PositionStatus_POSITION_STATUS_OPEN,
+		OpenedAt:    now.UnixMilli(),
+		OpenPrice:   openPrice,
+		Size:        proposal.Size,
+		InitialSize: proposal.Size,
+		MaxLoss:     storedMaxLoss(maxLoss(proposal.Legs, openPrice) * float64(proposal.Size)),
+	}
+	for _, leg := range proposal.Legs {
+		price, ok := result.LegFillPrices[leg.Option.Symbol]
+		if !ok {
+			price = mid(leg.Option)
+		}
+		position.Legs = append(
+			position.Legs, &botv1.PositionLeg{
+				Symbol:     leg.Option.Symbol,
+				RootSymbol: leg.RootSymbol,
+				OptionType: leg.Leg.GetOptionType(),
+				Strike:     leg.Option.Strike,
+				Expiration: leg.Expiration,
+				Quantity:   leg.Quantity * proposal.Size,
+				OpenPrice:  price,
+			},
+		)
+	}
+	return position
+}
+
+// CloseOptions tells how to close a position
+type CloseOptions struct {
+	// number of units to close, all if 0
+	Units int32
+	// a market order, or a limit order at mid
+	IsMarket bool
+	// recorded as the close reason of the position
+	Reason string
+}
+
+// Close closes an open position, a partial close splits the closed units to a new position
+// whose parent is the rest, and returns the closed one
+func (e *executor) Close(
+	ctx context.Context, position *botv1.Position, opts CloseOptions, now time.Time,
+) (*botv1.Position, error) {
+	logger := e.logger.With(slog.F("position_id", position.Id), slog.F("account_id", position.AccountId))
+	position, unlock, err := e.lockOpen(position)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+	client, err := e.open(position.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	target, rest := position, (*botv1.Position)(nil)
+	if opts.Units > 0 && opts.Units < position.Size {
+		target, rest = splitPosition(position, opts.Units)
+	}
+	mids := make(map[string]float64, len(target.Legs))
+	if options, err := legQuotes(ctx, target); err == nil {
+		for symbol, option := range options {
+			mids[symbol] = mid(option)
+		}
+	} else if !opts.IsMarket {
+		return nil, err
+	} else {
+		// a market order doesn't need quotes, e.g. to flatten when the data source is down
+		logger.Warn(ctx, "no quotes to close at market", slog.Error(err))
+	}
+	order := &account.Order{Underlying: target.Underlying, IsMarket: opts.IsMarket}
+	for _, leg := range target.Legs {
+		side := account.SellToClose
+		quantity := leg.Quantity
+		if quantity < 0 {
+			side = account.BuyToClose
+			quantity = -quantity
+		}
+		order.Legs = append(
+			order.Legs, account.OrderLeg{Symbol: leg.Symbol, Side: side, Quantity: quantity},
+		)
+		order.Price -= float64(leg.Quantity) * mids[leg.Symbol]
+	}
+	// the net price of one unit
+	order.Price /= float64(target.Size)
+	tick := util.Conf.Bot.PriceTick
+	order.Price = math.Round(order.Price/tick) * tick
+
+	orderID, err := client.Broker.PlaceOrder(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(
+		ctx, "close order placed", slog.F("order_id", orderID), slog.F("price", order.Price),
+		slog.F("size", target.Size), slog.F("reason", opts.Reason),
+	)
+	result, err := e.waitFill(ctx, client.Broker, orderID)
+	if err != nil {
+		return nil, err
+	}
+	// a partial fill closes the filled units, the rest stays open
+	if units := e.filledUnits(ctx, order, target.Size, result); units < target.Size {
+		if units == 0 {
+			return nil, xerrors.Errorf("order %s is not filled by a whole unit", orderID)
+		}
+		target, rest = splitPosition(position, units)
+	}
+	closed := proto.Clone(target).(*botv1.Position)
+	closed.Status = botv1.PositionStatus_POSITION_STATUS_CLOSED
+	closed.ClosedAt = now.UnixMilli()
+	closed.CloseReason = opts.Reason
+	closed.ClosePrice = order.Price
+	if result.FillPrice != 0 {
+		closed.ClosePrice = result.FillPrice
+	}
+	for _, leg := range closed.Legs {
+		leg.ClosePrice = mids[leg.Symbol]
+		if price, ok := result.LegFillPrices[leg.Symbol]; ok {
+			leg.ClosePrice = price
+		}
+	}
+	// both prices are paid debits, so the profit is what's not paid
+	closed.RealizedPnl = -(closed.OpenPrice + closed.ClosePrice) * float64(closed.Size) *
+		ContractMultiplier
+	if err := Positions.Save(closed); err != nil {
+		return nil, err
+	}
+	if rest != nil {
+		if err := Positions.Save(rest); err != nil {
+			return nil, err
+		}
+	}
+	logger.Info(
+		ctx, "position closed", slog.F("closed_id", closed.Id),
+		slog.F("realized_pnl", closed.RealizedPnl),
+	)
+	return closed, nil
+}
+
+// splitPosition splits units of the position to a new position, the rest keeps the id
+func splitPosition(position *botv1.Position, units int32) (*botv1.Position, *botv1.Position) {
+	part := proto.Clone(position).(*botv1.Position)
+	rest := proto.Clone(position).(*botv1.Position)
+	part.Id = util.NewID()
+	part.ParentId = position.Id
+	part.Size = units
+	rest.Size = position.Size - units
+	for i, leg := range position.Legs {
+		perUnit := leg.Quantity / position.Size
+		part.Legs[i].Quantity = perUnit * part.Size
+		rest.Legs[i].Quantity = perUnit * rest.Size
+	}
+	if position.MaxLoss != UndefinedRisk {
+		part.MaxLoss = position.MaxLoss * float64(part.Size) / float64(position.Size)
+		rest.MaxLoss = position.MaxLoss - part.MaxLoss
+	}
+	return part, rest
+}
+
+// legQuotes returns the option of every leg of the position by symbol
+func legQuotes(
+	ctx context.Context, position *botv1.Position,
+) (map[string]*datasourcev1.Option, error) {
+	market, err := positionDataSource(position)
+	if err != nil {
+		return nil, err
+	}
+	all := make(map[string]*datasourcev1.Option)
+	fetched := make(map[string]bool)
+	for _, leg := range position.Legs {
+		if fetched[leg.Expiration] {
+			continue
+		}
+		chains, err := market.GetOptionChains(ctx, position.Underlying, leg.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		for _, chain := range chains {
+			for _, options := range [][]*datasourcev1.Option{chain.Calls, chain.Puts} {
+				for _, option := range options {
+					all[option.Symbol] = option
+				}
+			}
+		}
+		fetched[leg.Expiration] = true
+	}
+	options := make(map[string]*datasourcev1.Option, len(position.Legs))
+	for _, leg := range position.Legs {
+		option, ok := all[leg.Symbol]
+		if !ok {
+			return nil, xerrors.Errorf("no quote of leg %s", leg.Symbol)
+		}
+		options[leg.Symbol] = option
+	}
+	return options, nil
+}
+
+// Roll replaces the tested side of an open position of the bot by the plan in one order after the risk checks,
+// the position is closed and the new one linked to it is returned
+func (e *executor) Roll(
+	ctx context.Context, bot *Bot, position *botv1.Position, plan *rollPlan, now time.Time,
+) (*botv1.Position, error) {
+	logger := e.logger.With(slog.F("position_id", position.Id), slog.F("account_id", position.AccountId))
+	position, unlockPosition, err := e.lockOpen(position)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockPosition()
+	// a roll opens new legs, which is blocked like any opening order
+	if err := checkHalted(); err != nil {
+		return nil, err
+	}
+	client, err := e.open(position.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	order := plan.Order(position, util.Conf.Bot.PriceTick)
+
+	unlock := e.lock(client.ID)
+	defer unlock()
+	// the new legs are opened, so they pass the same checks as an opening order
+	violations, err := CheckRollRisk(ctx, bot, client, plan.proposal(position, now), position, now)
+	if err != nil {
+		return nil, err
+	}
+	if len(violations) > 0 {
+		logger.Warn(ctx, "roll vetoed", slog.F("violations", violations))
+		return nil, xerrors.Errorf("%w: %s", ErrVetoed, strings.Join(violations, "; "))
+	}
+	if err := checkHalted(); err != nil {
+		return nil, err
+	}
+	orderID, err := client.Broker.PlaceOrder(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(
+		ctx, "roll order placed", slog.F("order_id", orderID), slog.F("price", order.Price),
+		slog.F("reason", plan.Reason),
+	)
+	result, err := e.waitFill(ctx, client.Broker, orderID)
+	if err != nil {
+		return nil, err
+	}
+	// the filled contracts of a partial roll are logged to be reconciled by hand,
+	// the position keeps its legs
+	if units := e.filledUnits(ctx, order, position.Size, result); units < position.Size {
+		return nil, xerrors.Errorf("roll order %s is partially filled, %d of %d units", orderID, units, position.Size)
+	}
+	closed, rolled := rolledPosition(position, plan, result, now)
+	if err := Positions.Save(rolled); err != nil {
+		return nil, err
+	}
+	if err := Positions.Save(closed); err != nil {
+		return nil, err
+	}
+	logger.Info(
+		ctx, "position rolled", slog.F("rolled_id", rolled.Id), slog.F("open_price", rolled.OpenPrice),
+	)
+	return rolled, nil
+}
@@ -0,0 +1,158 @@
+package bot
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// filledBroker fills every order at once, slowed down by delay
+type filledBroker struct {
+	account.Broker
+	delay       time.Duration
+	buyingPower float64
+	// the filled contracts of a partially filled order by symbol, every order fills if nil
+	filled map[string]float64
+
+	mu     sync.Mutex
+	orders []*account.Order
+}
+
+func (b *filledBroker) PlaceOrder(ctx context.Context, order *account.Order) (string, error) {
+	time.Sleep(b.delay)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.orders = append(b.orders, order)
+	return strconv.Itoa(len(b.orders)), nil
+}
+
+func (b *filledBroker) GetOrder(ctx context.Context, id string) (*account.OrderResult, error) {
+	if b.filled != nil {
+		return &account.OrderResult{
+			ID: id, Status: account.OrderStatusCanceled, FillPrice: 0.5, LegFilledQuantities: b.filled,
+		}, nil
+	}
+	return &account.OrderResult{Status: account.OrderStatusFilled, FillPrice: 0.5}, nil
+}
+
+func (b *filledBroker) GetBalances(ctx context.Context) (*account.Balances, error) {
+	return &account.Balances{OptionBuyingPower: b.buyingPower}, nil
+}
+
+func (b *filledBroker) placed() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.orders)
+}
+
+// useBroker stores positions in a temp dir and opens every account with the broker
+func useBroker(t *testing.T, broker account.Broker) {
+	util.Conf.Storage.Dir = t.TempDir()
+	Positions = &PositionStore{store: util.NewFileStore[*botv1.Position]("positions")}
+	open := Executor.open
+	Executor.open = func(id string) (*account.Client, error) {
+		return &account.Client{ID: id, Broker: broker}, nil
+	}
+	t.Cleanup(func() { Executor.open = open })
+}
+
+func openPosition(t *testing.T, id string) *botv1.Position {
+	position := &botv1.Position{
+		Id:         id,
+		AccountId:  "acc",
+		Underlying: "SPX",
+		Status:     botv1.PositionStatus_POSITION_STATUS_OPEN,
+		Size:       1,
+		OpenPrice:  -2,
+		Legs:       []*botv1.PositionLeg{{Symbol: "P4700", Quantity: -1, Expiration: "2024-01-19"}},
+	}
+	if err := Positions.Save(position); err != nil {
+		t.Fatal(err)
+	}
+	return position
+}
+
+func TestExecutor_Close_Concurrent(t *testing.T) {
+	broker := &filledBroker{delay: 20 * time.Millisecond}
+	useBroker(t, broker)
+	position := openPosition(t, "p1")
+
+	// e.g. the flatten, the exit engine and a manual close at once
+	var wg sync.WaitGroup
+	errs := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := Executor.Close(context.Background(), position, CloseOptions{IsMarket: true}, time.Now())
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	closed := 0
+	for err := range errs {
+		if err == nil {
+			closed++
+		} else {
+			assert.ErrorIs(t, err, ErrNotOpen)
+		}
+	}
+	assert.Equal(t, 1, closed)
+	assert.Equal(t, 1, broker.placed())
+
+	// a stale copy can't bring the position back
+	_, err := Executor.update(position, func(p *botv1.Position) { p.PeakUnitPnl = 1 })
+	assert.ErrorIs(t, err, ErrNotOpen)
+}
+
+func TestExecutor_Roll_Vetoed(t *testing.T) {
+	broker := &filledBroker{}
+	useBroker(t, broker)
+	position := openPosition(t, "p1")
+	plan := &rollPlan{
+		Close: position.Legs,
+		Open: []*botv1.PositionLeg{
+			{Symbol: "P4650", Strike: 4650, OptionType: botv1.OptionType_OPTION_TYPE_PUT, Quantity: -1},
+		},
+		Price: -0.5,
+	}
+	bot := &Bot{ID: "b1", Setting: &botv1.Setting{}}
+	_, err := Executor.Roll(context.Background(), bot, position, plan, time.Now())
+	assert.ErrorIs(t, err, ErrVetoed)
+	assert.Zero(t, broker.placed())
+}
+
+func TestExecutor_Close_PartiallyFilled(t *testing.T) {
+	broker := &filledBroker{filled: map[string]float64{"P4700": 2}}
+	useBroker(t, broker)
+	position := openPosition(t, "p1")
+	position.Size, position.Legs[0].Quantity = 3, -3
+	assert.NoError(t, Positions.Save(position))
+
+	// 2 of 3 units are closed, the last one stays open
+	closed, err := Executor.Close(context.Background(), position, CloseOptions{IsMarket: true}, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), closed.Size)
+	assert.Equal(t, "p1", closed.ParentId)
+	rest, err := Positions.Get("p1")
+	assert.NoError(t, err)
+	assert.Equal(t, botv1.PositionStatus_POSITION_STATUS_OPEN, rest.Status)
+	assert.Equal(t, int32(1), rest.Size)
+	assert.Equal(t, int32(-1), rest.Legs[0].Quantity)
+
+	// nothing filled, nothing changes
+	broker.filled = map[string]float64{"P4700": 0}
+	_, err = Executor.Close(context.Background(), rest, CloseOptions{IsMarket: true}, time.Now())
+	assert.Error(t, err)
+	rest, err = Positions.Get("p1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), rest.Size)
+}
@@ -0,0 +1,315 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/calendar"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+)
+
+var ExitEngine = &exitEngine{
+	running: make(map[string]bool),
+	logger:  util.DefaultLogger.With(slog.F("bot", "exit")),
+}
+
+// exitEngine rolls and closes the open positions of the auto close bots by their adjustments and exit rules
+type exitEngine struct {
+	// positions being evaluated, an order may wait up to the fill timeout
+	mu      sync.Mutex
+	running map[string]bool
+	wg      sync.WaitGroup
+	logger  slog.Logger
+}
+
+type exitDecision struct {
+	Reason string
+	// number of units to close, all if 0
+	Units int32
+	// a step of the profit target ladder
+	IsTarget bool
+}
+
+// Run evaluates every open position of the auto close bots once, each in its own goroutine so that
+// waiting for the fills doesn't block the scheduler, a position still evaluated since the last run is skipped
+func (e *exitEngine) Run(ctx context.Context, bots []*Bot, now time.Time) {
+	for _, bot := range bots {
+		if !bot.EnableAutoClose ||
+			(bot.Setting.GetExit() == nil && len(bot.Setting.GetAdjustments()) == 0) {
+			continue
+		}
+		positions, err := Positions.ListOpen(
+			func(p *botv1.Position) bool {
+				return p.BotId == bot.ID
+			},
+		)
+		if err != nil {
+			e.logger.Error(ctx, "failed to list positions", slog.F("bot_id", bot.ID), slog.Error(err))
+			continue
+		}
+		for _, position := range positions {
+			if !e.start(position.Id) {
+				continue
+			}
+			e.wg.Add(1)
+			go func(bot *Bot, position *botv1.Position) {
+				defer e.wg.Done()
+				defer e.finish(position.Id)
+				if err := e.evaluate(ctx, bot, position, now); err != nil && !xerrors.Is(err, ErrNotOpen) {
+					e.logger.Error(
+						ctx, "failed to evaluate exit", slog.F("position_id", position.Id), slog.Error(err),
+					)
+				}
+			}(bot, position)
+		}
+	}
+}
+
+// Wait blocks until the evaluations started by Run are done
+func (e *exitEngine) Wait() {
+	e.wg.Wait()
+}
+
+func (e *exitEngine) start(positionID string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.running[positionID] {
+		return false
+	}
+	e.running[positionID] = true
+	return true
+}
+
+func (e *exitEngine) finish(positionID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.running, positionID)
+}
+
+func (e *exitEngine) evaluate(
+	ctx context.Context, bot *Bot, position *botv1.Position, now time.Time,
+) error {
+	setting := bot.Setting
+	options, err := legQuotes(ctx, position)
+	if err != nil {
+		return err
+	}
+	if rolled, err := e.adjust(ctx, bot, position, options, now); err != nil {
+		// a failed roll falls back to the exit rules
+		e.logger.Error(ctx, "failed to roll", slog.F("position_id", position.Id), slog.Error(err))
+	} else if rolled {
+		return nil
+	}
+	if setting.GetExit() == nil {
+		return nil
+	}
+	decision, peak := evaluateExit(position, setting.Exit, options, now)
+	if peak != position.PeakUnitPnl {
+		position, err = Executor.update(
+			position, func(p *botv1.Position) {
+				p.PeakUnitPnl = peak
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	if decision == nil {
+		return nil
+	}
+	e.logger.Info(
+		ctx, "exit triggered", slog.F("position_id", position.Id), slog.F("reason", decision.Reason),
+		slog.F("units", decision.Units),
+	)
+	if _, err := Executor.Close(
+		ctx, position, CloseOptions{Units: decision.Units, Reason: decision.Reason}, now,
+	); err != nil {
+		return err
+	}
+	if decision.IsTarget && decision.Units > 0 {
+		// the rest of a scale-out waits for the next target
+		_, err := Executor.update(
+			position, func(p *botv1.Position) {
+				p.TargetsHit++
+			},
+		)
+		return err
+	}
+	return nil
+}
+
+// adjust rolls the position by the first triggered adjustment, true if rolled
+func (e *exitEngine) adjust(
+	ctx context.Context, bot *Bot, position *botv1.Position, options map[string]*datasourcev1.Option,
+	now time.Time,
+) (bool, error) {
+	adjustments := bot.Setting.GetAdjustments()
+	if len(adjustments) == 0 {
+		return false, nil
+	}
+	market, err := positionDataSource(position)
+	if err != nil {
+		return false, err
+	}
+	price := 0.0
+	for _, adjustment := range adjustments {
+		if adjustment.GetTriggerDistance() > 0 {
+			quotes, err := market.GetQuotes(ctx, []string{position.Underlying})
+			if err != nil {
+				return false, err
+			}
+			if len(quotes) == 0 {
+				return false, xerrors.Errorf("no quote of %s", position.Underlying)
+			}
+			price = quotePrice(quotes[0])
+			break
+		}
+	}
+	for _, adjustment := range adjustments {
+		if position.Rolls >= maxRolls(adjustment) || rollCooling(position, adjustment, now) {
+			continue
+		}
+		tested, reason := testedLeg(position, adjustment, options, price)
+		if tested == nil {
+			continue
+		}
+		plan, err := planRoll(ctx, market, position, adjustment, tested, options, now)
+		if err != nil {
+			return false, err
+		}
+		plan.Reason = reason
+		if adjustment.GetRequireCredit() && plan.Price >= 0 {
+			e.logger.Info(
+				ctx, "roll skipped without a credit", slog.F("position_id", position.Id),
+				slog.F("price", plan.Price), slog.F("reason", reason),
+			)
+			return false, nil
+		}
+		if retested := plan.retested(adjustment, price); retested != "" {
+			e.logger.Info(
+				ctx, "roll skipped as the new legs would be tested", slog.F("position_id", position.Id),
+				slog.F("reason", retested),
+			)
+			return false, nil
+		}
+		e.logger.Info(ctx, "roll triggered", slog.F("position_id", position.Id), slog.F("reason", reason))
+		if _, err := Executor.Roll(ctx, bot, position, plan, now); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// evaluateExit returns the first triggered exit rule, nil if none, and the updated peak profit of one unit,
+// rules protecting from losses go first
+func evaluateExit(
+	position *botv1.Position, exit *botv1.Exit, options map[string]*datasourcev1.Option, now time.Time,
+) (*exitDecision, float64) {
+	size := float64(position.Size)
+	unitValue := 0.0
+	for _, leg := range position.Legs {
+		unitValue += float64(leg.Quantity) * mid(options[leg.Symbol]) / size
+	}
+	unitPnl := (unitValue - position.OpenPrice) * ContractMultiplier
+	peak := math.Max(position.PeakUnitPnl, unitPnl)
+	// profit in the unit of the exit
+	inUnit := func(unitPnl float64) float64 {
+		if exit.GetUnit() == botv1.TargetUnit_TARGET_UNIT_DOLLAR {
+			return unitPnl * size
+		}
+		entry := math.Abs(position.OpenPrice) * ContractMultiplier
+		if entry == 0 {
+			return 0
+		}
+		return unitPnl / entry * 100
+	}
+	profit := inUnit(unitPnl)
+
+	if limit := exit.GetMaxShortDelta(); limit > 0 {
+		for _, leg := range position.Legs {
+			if delta := math.Abs(options[leg.Symbol].Delta); leg.Quantity < 0 && delta > limit {
+				return &exitDecision{
+					Reason: fmt.Sprintf("short leg %s delta %.2f exceeds %.2f", leg.Symbol, delta, limit),
+				}, peak
+			}
+		}
+	}
+	if stop := exit.GetStopLoss(); stop > 0 && profit <= -stop {
+		return &exitDecision{Reason: fmt.Sprintf("stop loss at %.2f", profit)}, peak
+	}
+	if trailing := exit.GetTrailingStop(); trailing != nil && trailing.Trail > 0 {
+		if top := inUnit(peak); top >= trailing.Activation && top-profit >= trailing.Trail {
+			return &exitDecision{
+				Reason: fmt.Sprintf("trailing stop at %.2f from peak %.2f", profit, top),
+			}, peak
+		}
+	}
+	if exit.GetDte() > 0 || exit.GetTime() != nil {
+		if dueByTime(position, exit, now) {
+			return &exitDecision{Reason: "time exit"}, peak
+		}
+	}
+	if target := exit.GetStopWin(); target > 0 && profit >= target {
+		return &exitDecision{Reason: fmt.Sprintf("stop win at %.2f", profit)}, peak
+	}
+	targets := make([]*botv1.ProfitTarget, len(exit.GetProfitTargets()))
+	copy(targets, exit.GetProfitTargets())
+	sort.SliceStable(
+		targets, func(i, j int) bool {
+			return targets[i].Profit < targets[j].Profit
+		},
+	)
+	if hit := int(position.TargetsHit); hit < len(targets) && profit >= targets[hit].Profit {
+		decision := &exitDecision{
+			Reason:   fmt.Sprintf("profit target %d at %.2f", hit+1, profit),
+			IsTarget: true,
+		}
+		initial := position.InitialSize
+		if initial == 0 {
+			initial = position.Size
+		}
+		percent := targets[hit].ClosePercent
+		if hit < len(targets)-1 && percent > 0 && percent < 100 {
+			units := int32(math.Round(float64(initial) * percent / 100))
+			if units < 1 {
+				units = 1
+			}
+			if units < position.Size {
+				decision.Units = units
+			}
+		}
+		return decision, peak
+	}
+	return nil, peak
+}
+
+// dueByTime is true if the nearest leg's DTE is at most the exit DTE, after the exit time of the day if set
+func dueByTime(position *botv1.Position, exit *botv1.Exit, now time.Time) bool {
+	dte := math.MaxInt
+	for _, leg := range position.Legs {
+		if d, err := calendar.DTE(now, leg.Expiration); err == nil && d < dte {
+			dte = d
+		}
+	}
+	if dte > int(exit.GetDte()) {
+		return false
+	}
+	if exit.GetTime() == nil {
+		return true
+	}
+	now = now.In(util.TZNewYork)
+	at := time.Date(
+		now.Year(), now.Month(), now.Day(), int(exit.Time.Hour), int(exit.Time.Minute), 0, 0,
+		util.TZNewYork,
+	)
+	return !now.Before(at)
+}
@@ -0,0 +1,101 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateExit(t *testing.T) {
+	now := time.Date(2024, 1, 16, 11, 0, 0, 0, util.TZNewYork)
+	// 4 units of a put credit spread for a credit of 2
+	newSpread := func() *botv1.Position {
+		return &botv1.Position{
+			Id:          "p1",
+			Size:        4,
+			InitialSize: 4,
+			OpenPrice:   -2,
+			Legs: []*botv1.PositionLeg{
+				{Symbol: "P4700", Quantity: -4, Expiration: "2024-01-19"},
+				{Symbol: "P4650", Quantity: 4, Expiration: "2024-01-19"},
+			},
+		}
+	}
+	// the spread is worth short - long
+	quotes := func(short, long, delta float64) map[string]*datasourcev1.Option {
+		return map[string]*datasourcev1.Option{
+			"P4700": {Bid: short, Ask: short, Delta: -delta},
+			"P4650": {Bid: long, Ask: long, Delta: -delta / 2},
+		}
+	}
+	exit := &botv1.Exit{
+		StopLoss: 100,
+		ProfitTargets: []*botv1.ProfitTarget{
+			{Profit: 75, ClosePercent: 100},
+			{Profit: 50, ClosePercent: 50},
+		},
+		TrailingStop:  &botv1.TrailingStop{Activation: 60, Trail: 20},
+		MaxShortDelta: 0.4,
+	}
+
+	// worth 1, 50% profit closes half by the first step of the ladder
+	position := newSpread()
+	decision, peak := evaluateExit(position, exit, quotes(1.5, 0.5, 0.1), now)
+	assert.True(t, decision.IsTarget)
+	assert.Equal(t, int32(2), decision.Units)
+	assert.InDelta(t, 100, peak, 1e-9)
+
+	// the rest waits for 75%
+	position.Size, position.TargetsHit = 2, 1
+	position.Legs[0].Quantity, position.Legs[1].Quantity = -2, 2
+	decision, _ = evaluateExit(position, exit, quotes(1.5, 0.5, 0.1), now)
+	assert.Nil(t, decision)
+	decision, _ = evaluateExit(position, exit, quotes(0.75, 0.25, 0.1), now)
+	assert.Equal(t, int32(0), decision.Units)
+
+	// peaked at 70%, back to 45%
+	position = newSpread()
+	position.PeakUnitPnl = 140
+	position.TargetsHit = 2
+	decision, _ = evaluateExit(position, exit, quotes(1.6, 0.5, 0.1), now)
+	assert.Equal(t, "trailing stop at 45.00 from peak 70.00", decision.Reason)
+
+	// worth 4, a loss of 100%
+	decision, _ = evaluateExit(newSpread(), exit, quotes(4.5, 0.5, 0.3), now)
+	assert.Equal(t, "stop loss at -100.00", decision.Reason)
+
+	// delta breach goes first
+	decision, _ = evaluateExit(newSpread(), exit, quotes(4.5, 0.5, 0.5), now)
+	assert.Equal(t, "short leg P4700 delta 0.50 exceeds 0.40", decision.Reason)
+
+	// in dollars of the whole position, and a time exit 2 days before the expiration
+	exit = &botv1.Exit{Unit: botv1.TargetUnit_TARGET_UNIT_DOLLAR, StopWin: 500, Dte: 2}
+	decision, _ = evaluateExit(newSpread(), exit, quotes(1.5, 0.5, 0.1), now)
+	assert.Nil(t, decision)
+	decision, _ = evaluateExit(newSpread(), exit, quotes(1.5, 0.5, 0.1), now.AddDate(0, 0, 1))
+	assert.Equal(t, "time exit", decision.Reason)
+	decision, _ = evaluateExit(newSpread(), exit, quotes(0.8, 0.5, 0.1), now)
+	assert.Equal(t, "stop win at 680.00", decision.Reason)
+}
+
+func TestSplitPosition(t *testing.T) {
+	position := &botv1.Position{
+		Id:      "p1",
+		Size:    4,
+		MaxLoss: 1200,
+		Legs:    []*botv1.PositionLeg{{Quantity: -4}, {Quantity: 8}},
+	}
+	part, rest := splitPosition(position, 1)
+	assert.Equal(t, "p1", part.ParentId)
+	assert.NotEqual(t, "p1", part.Id)
+	assert.Equal(t, int32(2), part.Legs[1].Quantity)
+	assert.Equal(t, 300.0, part.MaxLoss)
+	assert.Equal(t, "p1", rest.Id)
+	assert.Equal(t, int32(3), rest.Size)
+	assert.Equal(t, int32(-3), rest.Legs[0].Quantity)
+	assert.Equal(t, 900.0, rest.MaxLoss)
+}
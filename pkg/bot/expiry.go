@@ -0,0 +1,338 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/calendar"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+)
+
+var ExpiryProcessor = &expiryProcessor{
+	logger: util.DefaultLogger.With(slog.F("bot", "expiry")),
+}
+
+// expiryProcessor handles the positions at their expirations, it closes the expiring positions of
+// the auto close bots by their expiry policies, records the settlements of the legs left to expire,
+// and checks the accounts for stock positions from assignments
+type expiryProcessor struct {
+	logger slog.Logger
+}
+
+// Run processes every open position once, it runs out of the session too, since PM settled legs
+// settle at the close
+func (e *expiryProcessor) Run(ctx context.Context, cal *calendar.Calendar, now time.Time) {
+	bots, err := Bots.List(nil)
+	if err != nil {
+		e.logger.Error(ctx, "failed to list bots", slog.Error(err))
+		return
+	}
+	byID := make(map[string]*Bot, len(bots))
+	for _, bot := range bots {
+		byID[bot.ID] = bot
+	}
+	positions, err := Positions.ListOpen(nil)
+	if err != nil {
+		e.logger.Error(ctx, "failed to list positions", slog.Error(err))
+		return
+	}
+	for _, position := range positions {
+		err := e.process(ctx, byID[position.BotId], position, cal, now)
+		// closed meanwhile by an exit or the flatten
+		if err != nil && !xerrors.Is(err, ErrNotOpen) {
+			e.logger.Error(
+				ctx, "failed to process expiry", slog.F("position_id", position.Id), slog.Error(err),
+			)
+		}
+	}
+	if _, ok := cal.CurrentSession(now); ok {
+		e.checkAssignments(ctx, bots, now)
+	}
+}
+
+func (e *expiryProcessor) process(
+	ctx context.Context, bot *Bot, position *botv1.Position, cal *calendar.Calendar, now time.Time,
+) error {
+	// the bot may be deleted while its positions are still open
+	var expiry *botv1.Expiry
+	if bot != nil && bot.EnableAutoClose {
+		expiry = bot.Setting.GetExpiry()
+	}
+	settling := false
+	closing := false
+	before := util.Conf.Bot.ExpiryCloseBefore
+	if minutes := expiry.GetMinutesBeforeClose(); minutes > 0 {
+		before = time.Duration(minutes) * time.Minute
+	}
+	for _, leg := range position.Legs {
+		settleAt, err := cal.SettlementTime(leg.RootSymbol, leg.Expiration)
+		if err != nil {
+			return err
+		}
+		if !now.Before(settleAt) {
+			settling = true
+			continue
+		}
+		lastTrade, err := cal.LastTradeTime(leg.RootSymbol, leg.Expiration)
+		if err != nil {
+			return err
+		}
+		if !now.Before(lastTrade.Add(-before)) && now.Before(lastTrade) {
+			closing = true
+		}
+	}
+	policy := expiry.GetPolicy()
+	closing = closing && policy != botv1.ExpiryPolicy_EXPIRY_POLICY_UNSPECIFIED
+	if !settling && !closing {
+		return nil
+	}
+	market, err := dataSource(bot)
+	if err != nil {
+		return err
+	}
+	quotes, err := market.GetQuotes(ctx, []string{position.Underlying})
+	if err != nil {
+		return err
+	}
+	if len(quotes) == 0 {
+		return xerrors.Errorf("no quote of %s", position.Underlying)
+	}
+	quote := quotes[0]
+
+	if settling {
+		holdings, err := e.holdings(ctx, position, cal, now)
+		if err != nil {
+			return err
+		}
+		settled, err := Executor.replace(
+			position, func(current *botv1.Position) (*botv1.Position, error) {
+				return settlePosition(current, cal, quote, holdings, now)
+			},
+		)
+		if err != nil {
+			return err
+		}
+		e.logger.Info(
+			ctx, "expired legs settled", slog.F("position_id", position.Id),
+			slog.F("status", settled.Status), slog.F("realized_pnl", settled.RealizedPnl),
+		)
+		return nil
+	}
+	reason := ""
+	if policy == botv1.ExpiryPolicy_EXPIRY_POLICY_CLOSE_ALL {
+		reason = "close before expiration"
+	} else if reason = testedAtExpiry(
+		position, cal, quotePrice(quote), expiry.GetPinDistance(), before, now,
+	); reason == "" {
+		return nil
+	}
+	e.logger.Info(ctx, "expiry close triggered", slog.F("position_id", position.Id), slog.F("reason", reason))
+	_, err = Executor.Close(ctx, position, CloseOptions{Reason: reason}, now)
+	return err
+}
+
+// testedAtExpiry returns why a short leg closing to its last trade time is in the money or pinned,
+// empty if none
+func testedAtExpiry(
+	position *botv1.Position, cal *calendar.Calendar, price float64, pin float64, before time.Duration,
+	now time.Time,
+) string {
+	for _, leg := range position.Legs {
+		if leg.Quantity >= 0 {
+			continue
+		}
+		lastTrade, err := cal.LastTradeTime(leg.RootSymbol, leg.Expiration)
+		if err != nil || now.Before(lastTrade.Add(-before)) || !now.Before(lastTrade) {
+			continue
+		}
+		if intrinsic(leg.OptionType, leg.Strike, price) > 0 {
+			return fmt.Sprintf("short leg %s is in the money at %.2f", leg.Symbol, price)
+		}
+		if pin > 0 && price-leg.Strike <= pin && leg.Strike-price <= pin {
+			return fmt.Sprintf("short leg %s is pinned at %.2f", leg.Symbol, price)
+		}
+	}
+	return ""
+}
+
+// holdings returns the option holdings of the account of the position by symbol if it has physically
+// settled legs to settle, nil otherwise
+func (e *expiryProcessor) holdings(
+	ctx context.Context, position *botv1.Position, cal *calendar.Calendar, now time.Time,
+) (map[string]float64, error) {
+	physical := false
+	for _, leg := range position.Legs {
+		settleAt, err := cal.SettlementTime(leg.RootSymbol, leg.Expiration)
+		if err == nil && !now.Before(settleAt) && !calendar.IsCashSettled(leg.RootSymbol) {
+			physical = true
+		}
+	}
+	if !physical {
+		return nil, nil
+	}
+	client, err := Executor.open(position.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	list, err := client.Broker.GetPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	holdings := make(map[string]float64, len(list))
+	for _, holding := range list {
+		holdings[holding.Symbol] += holding.Quantity
+	}
+	return holdings, nil
+}
+
+// settlementPrice is the opening price of the expiration day for AM settled roots, otherwise the closing
+// price, which is the last price after the close or the previous close on the next session.
+// The quote must be of the expiration day, or of the next session for PM settled roots, and
+// the price must be positive, otherwise the settlement is retried later.
+func settlementPrice(
+	root string, expiration string, quote *datasourcev1.Quote, cal *calendar.Calendar, now time.Time,
+) (float64, error) {
+	at := now
+	if quote.QuoteAt > 0 {
+		at = time.UnixMilli(quote.QuoteAt)
+	}
+	price := 0.0
+	switch date := calendar.Date(at); {
+	case date == expiration && calendar.SettlementOf(root) == calendar.SettlementAM:
+		price = quote.Open
+	case date == expiration:
+		price = quotePrice(quote)
+	case calendar.SettlementOf(root) == calendar.SettlementPM:
+		if period, err := cal.PreviousSession(at); err == nil &&
+			calendar.Date(time.UnixMilli(period.CloseAt)) == expiration {
+			price = quote.PrevClose
+		}
+	}
+	if price <= 0 {
+		return 0, xerrors.Errorf(
+			"no settlement price of %s expiring %s in the quote of %s at %s",
+			root, expiration, quote.Symbol, at.In(util.TZNewYork).Format(time.DateTime),
+		)
+	}
+	return price, nil
+}
+
+// settlePosition settles the legs of the position whose settlement time passed by their intrinsic values,
+// a position without legs left is closed, otherwise the settled legs are moved to the expired legs
+// and their values are deducted from the open price.
+// A physically settled leg is only settled once the broker doesn't hold it anymore, holdings are
+// the option holdings of the account by symbol.
+func settlePosition(
+	position *botv1.Position, cal *calendar.Calendar, quote *datasourcev1.Quote, holdings map[string]float64,
+	now time.Time,
+) (*botv1.Position, error) {
+	settled := proto.Clone(position).(*botv1.Position)
+	settled.Legs = nil
+	var expired []*botv1.PositionLeg
+	// the net price per share of one unit to close the settled legs
+	closePrice := 0.0
+	for _, leg := range position.Legs {
+		settleAt, err := cal.SettlementTime(leg.RootSymbol, leg.Expiration)
+		if err != nil {
+			return nil, err
+		}
+		if now.Before(settleAt) {
+			settled.Legs = append(settled.Legs, leg)
+			continue
+		}
+		if quote == nil {
+			return nil, xerrors.Errorf("no quote of %s to settle", position.Underlying)
+		}
+		price, err := settlementPrice(leg.RootSymbol, leg.Expiration, quote, cal, now)
+		if err != nil {
+			return nil, err
+		}
+		leg = proto.Clone(leg).(*botv1.PositionLeg)
+		leg.ClosePrice = intrinsic(leg.OptionType, leg.Strike, price)
+		leg.Expired = true
+		if !calendar.IsCashSettled(leg.RootSymbol) {
+			if holdings == nil {
+				return nil, xerrors.Errorf("no holdings to settle the physically settled leg %s", leg.Symbol)
+			}
+			if holdings[leg.Symbol] != 0 {
+				return nil, xerrors.Errorf("leg %s is still held by the broker after expiration", leg.Symbol)
+			}
+			leg.Assigned = leg.ClosePrice > 0
+		}
+		expired = append(expired, leg)
+		closePrice -= float64(leg.Quantity) * leg.ClosePrice / float64(position.Size)
+	}
+	if len(expired) == 0 {
+		return settled, nil
+	}
+	if len(settled.Legs) > 0 {
+		settled.ExpiredLegs = append(settled.ExpiredLegs, expired...)
+		settled.OpenPrice += closePrice
+		return settled, nil
+	}
+	settled.Legs = expired
+	settled.Status = botv1.PositionStatus_POSITION_STATUS_CLOSED
+	settled.ClosedAt = now.UnixMilli()
+	settled.ClosePrice = closePrice
+	settled.CloseReason = "expired"
+	settled.RealizedPnl = -(settled.OpenPrice + settled.ClosePrice) * float64(settled.Size) *
+		ContractMultiplier
+	return settled, nil
+}
+
+// checkAssignments looks for stock positions in the underlyings of the bots once a trading day per account,
+// bots only trade options, so the shares are likely assigned or exercised
+func (e *expiryProcessor) checkAssignments(ctx context.Context, bots []*Bot, now time.Time) {
+	today := calendar.Date(now)
+	byAccount := make(map[string][]*Bot)
+	for _, bot := range bots {
+		checkedAt := bot.Status.GetAssignmentCheckedAt()
+		if bot.AccountID == "" || checkedAt > 0 && calendar.Date(time.UnixMilli(checkedAt)) == today {
+			continue
+		}
+		byAccount[bot.AccountID] = append(byAccount[bot.AccountID], bot)
+	}
+	for accountID, accountBots := range byAccount {
+		client, err := account.Open(accountID)
+		if err != nil {
+			e.logger.Error(ctx, "failed to open account", slog.F("account_id", accountID), slog.Error(err))
+			continue
+		}
+		holdings, err := client.Broker.GetPositions(ctx)
+		if err != nil {
+			e.logger.Error(ctx, "failed to get holdings", slog.F("account_id", accountID), slog.Error(err))
+			continue
+		}
+		for _, bot := range accountBots {
+			alerts := assignmentAlerts(bot.Setting.GetUnderlying(), holdings)
+			if len(alerts) > 0 {
+				e.logger.Warn(ctx, "stock position found", slog.F("bot_id", bot.ID), slog.F("alerts", alerts))
+			}
+			if err := Bots.UpdateStatus(
+				bot.ID, func(status *botv1.Status) {
+					status.AssignmentAlerts = alerts
+					status.AssignmentCheckedAt = now.UnixMilli()
+				},
+			); err != nil {
+				e.logger.Error(ctx, "failed to update bot status", slog.F("bot_id", bot.ID), slog.Error(err))
+			}
+		}
+	}
+}
+
+func assignmentAlerts(underlying string, holdings []account.Holding) []string {
+	var alerts []string
+	for _, holding := range holdings {
+		if holding.Symbol == underlying && holding.Quantity != 0 {
+			alerts = append(alerts, fmt.Sprintf("holding %v shares of %s", holding.Quantity, holding.Symbol))
+		}
+	}
+	return alerts
+}
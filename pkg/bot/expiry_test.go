@@ -0,0 +1,150 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/calendar"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettlePosition(t *testing.T) {
+	cal := calendar.NYSE()
+	// 2 units of a put credit spread opened for a credit of 2, and a long put of the next week
+	position := &botv1.Position{
+		Id:        "p1",
+		Status:    botv1.PositionStatus_POSITION_STATUS_OPEN,
+		OpenPrice: -2,
+		Size:      2,
+		Legs: []*botv1.PositionLeg{
+			{
+				Symbol: "P4700", RootSymbol: "SPXW", OptionType: botv1.OptionType_OPTION_TYPE_PUT,
+				Strike: 4700, Expiration: "2024-01-19", Quantity: -2,
+			},
+			{
+				Symbol: "P4650", RootSymbol: "SPXW", OptionType: botv1.OptionType_OPTION_TYPE_PUT,
+				Strike: 4650, Expiration: "2024-01-19", Quantity: 2,
+			},
+		},
+	}
+	quote := &datasourcev1.Quote{Symbol: "SPX", Open: 4600, Last: 4690}
+
+	// nothing settles before the close
+	settled, err := settlePosition(position, cal, quote, nil, time.Date(2024, 1, 19, 15, 0, 0, 0, util.TZNewYork))
+	assert.NoError(t, err)
+	assert.Len(t, settled.Legs, 2)
+	assert.Equal(t, botv1.PositionStatus_POSITION_STATUS_OPEN, settled.Status)
+
+	// settled by the close of 4690, the short put is worth 10
+	now := time.Date(2024, 1, 19, 16, 5, 0, 0, util.TZNewYork)
+	settled, err = settlePosition(position, cal, quote, nil, now)
+	assert.NoError(t, err)
+	assert.Equal(t, botv1.PositionStatus_POSITION_STATUS_CLOSED, settled.Status)
+	assert.True(t, settled.Legs[0].Expired)
+	assert.InDelta(t, 10, settled.Legs[0].ClosePrice, 1e-9)
+	assert.InDelta(t, 10, settled.ClosePrice, 1e-9)
+	assert.InDelta(t, -1600, settled.RealizedPnl, 1e-9)
+
+	// a leg of the next week stays open, the settlement goes to the open price
+	position.Legs = append(
+		position.Legs, &botv1.PositionLeg{
+			Symbol: "P4600", RootSymbol: "SPXW", OptionType: botv1.OptionType_OPTION_TYPE_PUT,
+			Strike: 4600, Expiration: "2024-01-26", Quantity: 2,
+		},
+	)
+	settled, err = settlePosition(position, cal, quote, nil, now)
+	assert.NoError(t, err)
+	assert.Equal(t, botv1.PositionStatus_POSITION_STATUS_OPEN, settled.Status)
+	assert.Len(t, settled.Legs, 1)
+	assert.Len(t, settled.ExpiredLegs, 2)
+	assert.InDelta(t, 8, settled.OpenPrice, 1e-9)
+
+	// an AM settled leg settles by the open of the expiration day
+	position.Legs = position.Legs[:1]
+	position.Legs[0].RootSymbol = "SPX"
+	settled, err = settlePosition(position, cal, quote, nil, time.Date(2024, 1, 19, 10, 0, 0, 0, util.TZNewYork))
+	assert.NoError(t, err)
+	assert.InDelta(t, 100, settled.Legs[0].ClosePrice, 1e-9)
+
+	// no settlement price without the open, or with a quote of another day
+	_, err = settlePosition(
+		position, cal, &datasourcev1.Quote{Symbol: "SPX", Last: 4690}, nil,
+		time.Date(2024, 1, 19, 10, 0, 0, 0, util.TZNewYork),
+	)
+	assert.Error(t, err)
+	stale := &datasourcev1.Quote{
+		Symbol: "SPX", Open: 4600, QuoteAt: time.Date(2024, 1, 18, 16, 0, 0, 0, util.TZNewYork).UnixMilli(),
+	}
+	_, err = settlePosition(position, cal, stale, nil, time.Date(2024, 1, 19, 10, 0, 0, 0, util.TZNewYork))
+	assert.Error(t, err)
+
+	// a PM settled leg settles by the previous close on the next session
+	position.Legs[0].RootSymbol = "SPXW"
+	next := &datasourcev1.Quote{
+		Symbol: "SPX", PrevClose: 4690, Last: 4800,
+		QuoteAt: time.Date(2024, 1, 22, 10, 0, 0, 0, util.TZNewYork).UnixMilli(),
+	}
+	settled, err = settlePosition(position, cal, next, nil, time.Date(2024, 1, 22, 10, 0, 0, 0, util.TZNewYork))
+	assert.NoError(t, err)
+	assert.InDelta(t, 10, settled.Legs[0].ClosePrice, 1e-9)
+}
+
+func TestSettlePosition_Physical(t *testing.T) {
+	cal := calendar.NYSE()
+	position := &botv1.Position{
+		Id:        "p1",
+		Status:    botv1.PositionStatus_POSITION_STATUS_OPEN,
+		OpenPrice: -1,
+		Size:      1,
+		Legs: []*botv1.PositionLeg{
+			{
+				Symbol: "SPY240119P00470000", RootSymbol: "SPY", OptionType: botv1.OptionType_OPTION_TYPE_PUT,
+				Strike: 470, Expiration: "2024-01-19", Quantity: -1,
+			},
+		},
+	}
+	quote := &datasourcev1.Quote{Symbol: "SPY", Last: 469}
+	now := time.Date(2024, 1, 19, 16, 5, 0, 0, util.TZNewYork)
+
+	// not settled without the holdings, or while the broker still holds the leg
+	_, err := settlePosition(position, cal, quote, nil, now)
+	assert.Error(t, err)
+	_, err = settlePosition(position, cal, quote, map[string]float64{"SPY240119P00470000": -1}, now)
+	assert.Error(t, err)
+
+	// assigned once the broker delivered the shares
+	settled, err := settlePosition(position, cal, quote, map[string]float64{"SPY": 100}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, botv1.PositionStatus_POSITION_STATUS_CLOSED, settled.Status)
+	assert.True(t, settled.Legs[0].Assigned)
+	assert.InDelta(t, 1, settled.Legs[0].ClosePrice, 1e-9)
+}
+
+func TestTestedAtExpiry(t *testing.T) {
+	cal := calendar.NYSE()
+	position := &botv1.Position{
+		Legs: []*botv1.PositionLeg{
+			{
+				Symbol: "C480", RootSymbol: "SPY", OptionType: botv1.OptionType_OPTION_TYPE_CALL,
+				Strike: 480, Expiration: "2024-01-19", Quantity: -1,
+			},
+		},
+	}
+	now := time.Date(2024, 1, 19, 15, 50, 0, 0, util.TZNewYork)
+	assert.Contains(t, testedAtExpiry(position, cal, 481, 0, 15*time.Minute, now), "in the money")
+	assert.Empty(t, testedAtExpiry(position, cal, 479, 0, 15*time.Minute, now))
+	assert.Contains(t, testedAtExpiry(position, cal, 479, 1, 15*time.Minute, now), "pinned")
+	// too early to close
+	assert.Empty(
+		t, testedAtExpiry(position, cal, 481, 0, 15*time.Minute, now.Add(-time.Hour)),
+	)
+
+	alerts := assignmentAlerts(
+		"SPY", []account.Holding{{Symbol: "SPY", Quantity: -100}, {Symbol: "SPY240119C00480000", Quantity: 1}},
+	)
+	assert.Equal(t, []string{"holding -100 shares of SPY"}, alerts)
+}
@@ -0,0 +1,172 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/calendar"
+	accountv1 "github.com/ppaanngggg/option-bot/proto/gen/account/v1"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+)
+
+// CheckRisk returns the reasons to block the proposal of the bot by the limits of the bot and its account,
+// an empty list means the order may be placed
+func CheckRisk(
+	ctx context.Context, bot *Bot, client *account.Client, proposal *Proposal, now time.Time,
+) ([]string, error) {
+	return checkAccountRisk(ctx, bot, client, proposal, nil, now)
+}
+
+// CheckRollRisk is CheckRisk of the position after a roll, which replaces the rolled position
+func CheckRollRisk(
+	ctx context.Context, bot *Bot, client *account.Client, proposal *Proposal, rolled *botv1.Position,
+	now time.Time,
+) ([]string, error) {
+	return checkAccountRisk(ctx, bot, client, proposal, rolled, now)
+}
+
+func checkAccountRisk(
+	ctx context.Context, bot *Bot, client *account.Client, proposal *Proposal, replaces *botv1.Position,
+	now time.Time,
+) ([]string, error) {
+	open, err := Positions.ListOpen(
+		func(p *botv1.Position) bool {
+			return p.AccountId == client.ID && (replaces == nil || p.Id != replaces.Id)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	today := calendar.Date(now)
+	closedToday, err := Positions.List(
+		func(p *botv1.Position) bool {
+			return p.AccountId == client.ID &&
+				p.Status == botv1.PositionStatus_POSITION_STATUS_CLOSED &&
+				calendar.Date(time.UnixMilli(p.ClosedAt)) == today
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	balances, err := client.Broker.GetBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return checkRisk(
+		&riskInput{
+			bot:         bot,
+			limits:      client.Setting.GetRiskLimits(),
+			proposal:    proposal,
+			open:        open,
+			closedToday: closedToday,
+			balances:    balances,
+			replaces:    replaces,
+		},
+	), nil
+}
+
+type riskInput struct {
+	bot      *Bot
+	limits   *accountv1.RiskLimits
+	proposal *Proposal
+	// open positions of the account
+	open []*botv1.Position
+	// positions of the account closed today
+	closedToday []*botv1.Position
+	balances    *account.Balances
+	// the position replaced by the proposal, e.g. by a roll, whose buying power is released
+	replaces *botv1.Position
+}
+
+func checkRisk(in *riskInput) []string {
+	var violations []string
+	violate := func(format string, args ...any) {
+		violations = append(violations, fmt.Sprintf(format, args...))
+	}
+	proposal := in.proposal
+
+	if limit := in.bot.Setting.GetMaxOpenPositions(); limit > 0 {
+		count := 0
+		for _, p := range in.open {
+			if p.BotId == in.bot.ID {
+				count++
+			}
+		}
+		if count >= int(limit) {
+			violate("bot has %d open positions, limit %d", count, limit)
+		}
+	}
+	if limit := in.limits.GetMaxOpenPositions(); limit > 0 && len(in.open) >= int(limit) {
+		violate("account has %d open positions, limit %d", len(in.open), limit)
+	}
+	if limit := in.limits.GetMaxTotalMaxLoss(); limit > 0 {
+		total := proposal.MaxLoss
+		for _, p := range in.open {
+			if p.MaxLoss == UndefinedRisk {
+				total = math.Inf(1)
+				break
+			}
+			total += p.MaxLoss
+		}
+		if total > limit {
+			violate("total max loss %.2f would exceed limit %.2f", total, limit)
+		}
+	}
+	if limit := in.limits.GetMaxNotionalPerUnderlying(); limit > 0 {
+		notional := proposal.Notional
+		for _, p := range in.open {
+			if p.Underlying == proposal.Underlying {
+				notional += positionNotional(p)
+			}
+		}
+		if notional > limit {
+			violate(
+				"notional %.2f of %s would exceed limit %.2f", notional, proposal.Underlying, limit,
+			)
+		}
+	}
+	if limit := in.limits.GetMaxDailyLoss(); limit > 0 {
+		pnl := 0.0
+		for _, p := range in.closedToday {
+			pnl += p.RealizedPnl
+		}
+		if -pnl >= limit {
+			violate("daily loss %.2f reached limit %.2f", -pnl, limit)
+		}
+	}
+	if in.balances != nil {
+		// a defined risk position holds its max loss, otherwise its notional is a conservative estimate
+		required := proposal.MaxLoss
+		if math.IsInf(required, 1) {
+			required = proposal.Notional
+		}
+		if in.replaces != nil {
+			held := in.replaces.MaxLoss
+			if held == UndefinedRisk {
+				held = positionNotional(in.replaces)
+			}
+			required -= held
+		}
+		if required > in.balances.OptionBuyingPower {
+			violate(
+				"requires buying power %.2f, available %.2f",
+				required, in.balances.OptionBuyingPower,
+			)
+		}
+	}
+	return violations
+}
+
+// positionNotional is the notional in dollars of the short legs of an open position
+func positionNotional(p *botv1.Position) float64 {
+	notional := 0.0
+	for _, leg := range p.Legs {
+		if leg.Quantity < 0 {
+			notional += float64(-leg.Quantity) * leg.Strike * ContractMultiplier
+		}
+	}
+	return notional
+}
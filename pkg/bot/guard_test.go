@@ -0,0 +1,64 @@
+package bot
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	accountv1 "github.com/ppaanngggg/option-bot/proto/gen/account/v1"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRisk(t *testing.T) {
+	bot := &Bot{ID: "b1", Setting: &botv1.Setting{MaxOpenPositions: 1}}
+	open := []*botv1.Position{
+		{
+			Id:         "p1",
+			BotId:      "b1",
+			Underlying: "SPX",
+			MaxLoss:    4000,
+			Legs:       []*botv1.PositionLeg{{Strike: 4700, Quantity: -1}, {Strike: 4650, Quantity: 1}},
+		},
+	}
+	proposal := &Proposal{Underlying: "SPX", Size: 1, MaxLoss: 4800, Notional: 470000}
+	in := &riskInput{
+		bot:      bot,
+		limits:   &accountv1.RiskLimits{},
+		proposal: proposal,
+		balances: &account.Balances{OptionBuyingPower: 10000},
+	}
+	assert.Empty(t, checkRisk(in))
+
+	in.open = open
+	in.closedToday = []*botv1.Position{{RealizedPnl: -1500}}
+	in.limits = &accountv1.RiskLimits{
+		MaxOpenPositions:         1,
+		MaxTotalMaxLoss:          8000,
+		MaxNotionalPerUnderlying: 900000,
+		MaxDailyLoss:             1000,
+	}
+	in.balances.OptionBuyingPower = 1000
+	assert.Equal(
+		t, []string{
+			"bot has 1 open positions, limit 1",
+			"account has 1 open positions, limit 1",
+			"total max loss 8800.00 would exceed limit 8000.00",
+			"notional 940000.00 of SPX would exceed limit 900000.00",
+			"daily loss 1500.00 reached limit 1000.00",
+			"requires buying power 4800.00, available 1000.00",
+		}, checkRisk(in),
+	)
+
+	// undefined risk requires its notional as buying power
+	in.open, in.closedToday, in.limits = nil, nil, nil
+	proposal.MaxLoss = math.Inf(1)
+	in.balances.OptionBuyingPower = 500000
+	assert.Empty(t, checkRisk(in))
+
+	// a roll releases the buying power of the replaced position
+	proposal.MaxLoss = 4800
+	in.balances.OptionBuyingPower = 1000
+	in.replaces = &botv1.Position{MaxLoss: 4000}
+	assert.Empty(t, checkRisk(in))
+}
@@ -0,0 +1,95 @@
+package bot
+
+import (
+	"math"
+	"sort"
+
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+)
+
+// payoffAt returns the profit per share of one unit at the expiration when the underlying is at price,
+// legs of later expirations are valued by their intrinsic values too, which ignores their time values
+func payoffAt(legs []*ProposalLeg, openPrice float64, price float64) float64 {
+	value := 0.0
+	for _, leg := range legs {
+		value += float64(leg.Quantity) * intrinsic(leg.Leg.GetOptionType(), leg.Option.Strike, price)
+	}
+	return value - openPrice
+}
+
+func intrinsic(optionType botv1.OptionType, strike float64, price float64) float64 {
+	if optionType == botv1.OptionType_OPTION_TYPE_CALL {
+		return math.Max(price-strike, 0)
+	}
+	return math.Max(strike-price, 0)
+}
+
+// maxLoss returns the max loss in dollars of one unit, +Inf if the loss is unlimited,
+// the payoff is piecewise linear, so the minimum is at 0, a strike or the upside tail
+func maxLoss(legs []*ProposalLeg, openPrice float64) float64 {
+	slope := 0.0
+	for _, leg := range legs {
+		if leg.Leg.GetOptionType() == botv1.OptionType_OPTION_TYPE_CALL {
+			slope += float64(leg.Quantity)
+		}
+	}
+	if slope < 0 {
+		return math.Inf(1)
+	}
+	worst := payoffAt(legs, openPrice, 0)
+	for _, leg := range legs {
+		worst = math.Min(worst, payoffAt(legs, openPrice, leg.Option.Strike))
+	}
+	if worst >= 0 {
+		return 0
+	}
+	return -worst * ContractMultiplier
+}
+
+// UndefinedRisk is the stored max loss of an undefined risk position, since json has no infinity
+const UndefinedRisk = -1
+
+// storedMaxLoss converts an infinite max loss to UndefinedRisk
+func storedMaxLoss(loss float64) float64 {
+	if math.IsInf(loss, 1) {
+		return UndefinedRisk
+	}
+	return loss
+}
+
+// payoffSamples is the number of sampled underlying prices of a payoff curve besides the strikes
+const payoffSamples = 50
+
+// payoffCurve returns the profit in dollars of all units at the expiration around the strikes,
+// the strikes are always sampled since the payoff bends there
+func payoffCurve(legs []*ProposalLeg, openPrice float64, size int32) []*botv1.PayoffPoint {
+	if len(legs) == 0 {
+		return nil
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	prices := make([]float64, 0, payoffSamples+1+len(legs))
+	for _, leg := range legs {
+		lo = math.Min(lo, leg.Option.Strike)
+		hi = math.Max(hi, leg.Option.Strike)
+		prices = append(prices, leg.Option.Strike)
+	}
+	margin := math.Max(hi-lo, lo*0.05)
+	from, to := math.Max(lo-margin, 0), hi+margin
+	for i := 0; i <= payoffSamples; i++ {
+		prices = append(prices, from+(to-from)*float64(i)/payoffSamples)
+	}
+	sort.Float64s(prices)
+	points := make([]*botv1.PayoffPoint, 0, len(prices))
+	for _, price := range prices {
+		if n := len(points); n > 0 && points[n-1].UnderlyingPrice == price {
+			continue
+		}
+		points = append(
+			points, &botv1.PayoffPoint{
+				UnderlyingPrice: price,
+				Pnl:             payoffAt(legs, openPrice, price) * float64(size) * ContractMultiplier,
+			},
+		)
+	}
+	return points
+}
@@ -0,0 +1,48 @@
+package bot
+
+import (
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	"golang.org/x/xerrors"
+)
+
+// ContractMultiplier is the number of shares per option contract
+const ContractMultiplier = 100
+
+var Positions = &PositionStore{
+	store: util.NewFileStore[*botv1.Position]("positions"),
+}
+
+// PositionStore keeps the positions opened by bots, both open and closed ones
+type PositionStore struct {
+	store *util.FileStore[*botv1.Position]
+}
+
+func (s *PositionStore) Get(id string) (*botv1.Position, error) {
+	position, ok, err := s.store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, xerrors.Errorf("position not found, id: %s", id)
+	}
+	return position, nil
+}
+
+// List returns the positions matching the filter, a nil filter matches all
+func (s *PositionStore) List(filter func(p *botv1.Position) bool) ([]*botv1.Position, error) {
+	return s.store.List(filter)
+}
+
+// ListOpen returns the open positions matching the filter, a nil filter matches all
+func (s *PositionStore) ListOpen(filter func(p *botv1.Position) bool) ([]*botv1.Position, error) {
+	return s.store.List(
+		func(p *botv1.Position) bool {
+			return p.Status == botv1.PositionStatus_POSITION_STATUS_OPEN && (filter == nil || filter(p))
+		},
+	)
+}
+
+func (s *PositionStore) Save(position *botv1.Position) error {
+	return s.store.Put(position.Id, position)
+}
@@ -0,0 +1,60 @@
+package bot
+
+import (
+	"context"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+)
+
+// Preview runs the same resolution and risk checks as opening a position of the bot,
+// but places nothing and changes no status
+func Preview(ctx context.Context, bot *Bot, now time.Time) (*botv1.PreviewResponse, error) {
+	client, err := account.Open(bot.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	ds, err := dataSource(bot)
+	if err != nil {
+		return nil, err
+	}
+	proposal, err := Resolve(ctx, ds, bot.Setting, now)
+	if err != nil {
+		return nil, err
+	}
+	violations, err := CheckRisk(ctx, bot, client, proposal, now)
+	if err != nil {
+		return nil, err
+	}
+	run := &botv1.Run{}
+	recordProposal(run, proposal)
+	return &botv1.PreviewResponse{
+		Chains:         run.Chains,
+		Legs:           run.Legs,
+		Size:           run.Size,
+		Price:          run.Price,
+		MaxLoss:        run.MaxLoss,
+		Order:          orderPreview(proposal.Order(util.Conf.Bot.PriceTick)),
+		RiskViolations: violations,
+		Payoff:         payoffCurve(proposal.Legs, proposal.Price, proposal.Size),
+	}, nil
+}
+
+func orderPreview(order *account.Order) *botv1.OrderPreview {
+	preview := &botv1.OrderPreview{
+		Underlying: order.Underlying,
+		Price:      order.Price,
+	}
+	for _, leg := range order.Legs {
+		preview.Legs = append(
+			preview.Legs, &botv1.OrderLeg{
+				Symbol:   leg.Symbol,
+				Side:     leg.Side.String(),
+				Quantity: leg.Quantity,
+			},
+		)
+	}
+	return preview
+}
@@ -0,0 +1,410 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/calendar"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+)
+
+// matchTolerance is the tolerance of an exact match of deltas and prices
+const matchTolerance = 1e-6
+
+var resolveLogger = util.DefaultLogger.With(slog.F("bot", "resolve"))
+
+// ProposalLeg is a leg of the setting resolved to a contract
+type ProposalLeg struct {
+	Leg        *botv1.Leg
+	RootSymbol string
+	Expiration string
+	Option     *datasourcev1.Option
+	// signed quantity of one unit, positive for long and negative for short
+	Quantity int32
+}
+
+// Proposal is a position a bot would open now
+type Proposal struct {
+	Underlying string
+	Legs       []*ProposalLeg
+	// the chains considered, e.g. "SPXW 2024-01-19"
+	Chains []string
+	// net mid price per share of one unit, positive for debit and negative for credit
+	Price float64
+	// number of units decided by the allocation
+	Size int32
+	// the max loss in dollars of all units, +Inf for undefined risk
+	MaxLoss float64
+	// the notional in dollars of the short legs of all units
+	Notional float64
+}
+
+// Resolve chooses the expiration and strike of every leg of the setting by the chains of the market,
+// and sizes the position by the allocation
+func Resolve(
+	ctx context.Context, market account.Market, setting *botv1.Setting, now time.Time,
+) (*Proposal, error) {
+	if setting.GetUnderlying() == "" || len(setting.GetLegs()) == 0 {
+		return nil, xerrors.New("setting has no underlying or legs")
+	}
+	expirations, err := market.GetOptionExpirations(ctx, setting.Underlying)
+	if err != nil {
+		return nil, err
+	}
+	chains := make(map[string]*datasourcev1.Chain)
+	proposal := &Proposal{Underlying: setting.Underlying}
+	for i, leg := range setting.Legs {
+		expiration, err := chooseExpiration(expirations, leg.Dte, now)
+		if err != nil {
+			return nil, xerrors.Errorf("leg %d: %w", i, err)
+		}
+		chain, ok := chains[expiration]
+		if !ok {
+			list, err := market.GetOptionChains(ctx, setting.Underlying, expiration)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range list {
+				proposal.Chains = append(proposal.Chains, c.RootSymbol+" "+expiration)
+			}
+			if chain = pickChain(list); chain == nil {
+				return nil, xerrors.Errorf("no option chain of %s at %s", setting.Underlying, expiration)
+			}
+			chains[expiration] = chain
+		}
+		options := chain.Puts
+		if leg.OptionType == botv1.OptionType_OPTION_TYPE_CALL {
+			options = chain.Calls
+		}
+		option, err := chooseOption(ctx, options, leg.Strike, now)
+		if err != nil {
+			return nil, xerrors.Errorf("leg %d: %w", i, err)
+		}
+		quantity := leg.Quantity
+		if quantity <= 0 {
+			return nil, xerrors.Errorf("leg %d: quantity must be positive", i)
+		}
+		if leg.Action == botv1.Action_ACTION_SHORT {
+			quantity = -quantity
+		}
+		proposal.Legs = append(
+			proposal.Legs, &ProposalLeg{
+				Leg:        leg,
+				RootSymbol: chain.RootSymbol,
+				Expiration: expiration,
+				Option:     option,
+				Quantity:   quantity,
+			},
+		)
+		proposal.Price += float64(quantity) * mid(option)
+	}
+	unitMaxLoss := maxLoss(proposal.Legs, proposal.Price)
+	size, err := allocate(setting.Allocation, unitMaxLoss)
+	if err != nil {
+		return nil, err
+	}
+	proposal.Size = size
+	proposal.MaxLoss = unitMaxLoss * float64(size)
+	for _, leg := range proposal.Legs {
+		if leg.Quantity < 0 {
+			proposal.Notional += float64(-leg.Quantity*size) * leg.Option.Strike * ContractMultiplier
+		}
+	}
+	return proposal, nil
+}
+
+// Order builds the opening order at the net mid price rounded to the tick
+func (p *Proposal) Order(tick float64) *account.Order {
+	order := &account.Order{
+		Underlying: p.Underlying,
+		Price:      math.Round(p.Price/tick) * tick,
+	}
+	for _, leg := range p.Legs {
+		side := account.BuyToOpen
+		quantity := leg.Quantity * p.Size
+		if quantity < 0 {
+			side = account.SellToOpen
+			quantity = -quantity
+		}
+		order.Legs = append(
+			order.Legs, account.OrderLeg{Symbol: leg.Option.Symbol, Side: side, Quantity: quantity},
+		)
+	}
+	return order
+}
+
+// pickChain prefers a PM settled chain, since bots manage positions until the close,
+// e.g. SPXW over SPX
+func pickChain(chains []*datasourcev1.Chain) *datasourcev1.Chain {
+	var picked *datasourcev1.Chain
+	for _, chain := range chains {
+		if calendar.SettlementOf(chain.RootSymbol) == calendar.SettlementPM {
+			return chain
+		}
+		if picked == nil {
+			picked = chain
+		}
+	}
+	return picked
+}
+
+func chooseExpiration(expirations []string, setting *botv1.DTE, now time.Time) (string, error) {
+	candidates := make([]string, 0, len(expirations))
+	values := make([]float64, 0, len(expirations))
+	for _, expiration := range expirations {
+		dte, err := calendar.DTE(now, expiration)
+		if err != nil {
+			return "", err
+		}
+		if dte < 0 || !inIntRange(dte, setting.GetDteRange()) {
+			continue
+		}
+		candidates = append(candidates, expiration)
+		values = append(values, float64(dte))
+	}
+	i := matchIndex(values, float64(setting.GetDte()), setting.GetMatch())
+	if i < 0 {
+		return "", xerrors.Errorf("no expiration matches dte %d", setting.GetDte())
+	}
+	return candidates[i], nil
+}
+
+func chooseOption(
+	ctx context.Context, options []*datasourcev1.Option, setting *botv1.Strike, now time.Time,
+) (*datasourcev1.Option, error) {
+	liquidity := sourceLiquidity(ctx, options, setting.GetLiquidity())
+	candidates := make([]*datasourcev1.Option, 0, len(options))
+	values := make([]float64, 0, len(options))
+	var target float64
+	illiquid := 0
+	for _, option := range options {
+		// an option without an ask can't be traded
+		if option.Ask <= 0 {
+			continue
+		}
+		var value float64
+		switch setting.GetChooser() {
+		case botv1.StrikeChooser_STRIKE_CHOOSER_DELTA:
+			// puts have negative deltas, compare absolute values
+			value, target = math.Abs(option.Delta), math.Abs(setting.Delta)
+			if !inDoubleRange(value, setting.DeltaRange) {
+				continue
+			}
+		case botv1.StrikeChooser_STRIKE_CHOOSER_PRICE:
+			value, target = mid(option), setting.Price
+			if !inDoubleRange(value, setting.PriceRange) {
+				continue
+			}
+		default:
+			return nil, xerrors.Errorf("unsupported strike chooser: %s", setting.GetChooser())
+		}
+		if illiquidReason(option, liquidity, now) != "" {
+			illiquid++
+			continue
+		}
+		candidates = append(candidates, option)
+		values = append(values, value)
+	}
+	i := matchIndex(values, target, setting.GetMatch())
+	if i < 0 {
+		if illiquid > 0 {
+			return nil, xerrors.Errorf(
+				"no strike matches %s %v, %d strikes are illiquid", setting.GetChooser(), target, illiquid,
+			)
+		}
+		return nil, xerrors.Errorf("no strike matches %s %v", setting.GetChooser(), target)
+	}
+	chosen := candidates[i]
+	if setting.GetStrikeOffset() == 0 {
+		return chosen, nil
+	}
+	return offsetOption(ctx, options, chosen.Strike+setting.StrikeOffset, setting, liquidity, now)
+}
+
+// sourceLiquidity drops the min open interest if no option has any, since some sources never report it,
+// e.g. alpaca, checking it would reject every strike, the other checks still apply
+func sourceLiquidity(
+	ctx context.Context, options []*datasourcev1.Option, liquidity *botv1.Liquidity,
+) *botv1.Liquidity {
+	if liquidity.GetMinOpenInterest() <= 0 {
+		return liquidity
+	}
+	for _, option := range options {
+		if option.OpenInterest > 0 {
+			return liquidity
+		}
+	}
+	resolveLogger.Warn(
+		ctx, "no open interest from the source, min open interest is skipped",
+		slog.F("min_open_interest", liquidity.MinOpenInterest),
+	)
+	liquidity = proto.Clone(liquidity).(*botv1.Liquidity)
+	liquidity.MinOpenInterest = 0
+	return liquidity
+}
+
+// offsetOption returns the option at strike, or the nearest liquid one beyond it in the direction of the offset
+// by at most one more offset, so that the wing doesn't drift far from the intended width
+func offsetOption(
+	ctx context.Context, options []*datasourcev1.Option, strike float64, setting *botv1.Strike,
+	liquidity *botv1.Liquidity, now time.Time,
+) (*datasourcev1.Option, error) {
+	var best *datasourcev1.Option
+	reason := ""
+	for _, option := range options {
+		diff := option.Strike - strike
+		if math.Abs(diff) < matchTolerance {
+			if reason = illiquidReason(option, liquidity, now); reason == "" {
+				return option, nil
+			}
+			continue
+		}
+		// beyond the strike in the direction of the offset, within one more offset
+		if diff*setting.StrikeOffset < 0 || math.Abs(diff) > math.Abs(setting.StrikeOffset)+matchTolerance ||
+			option.Ask <= 0 {
+			continue
+		}
+		if illiquidReason(option, liquidity, now) != "" {
+			continue
+		}
+		if best == nil || math.Abs(diff) < math.Abs(best.Strike-strike) {
+			best = option
+		}
+	}
+	if best != nil && reason != "" {
+		resolveLogger.Info(
+			ctx, "illiquid strike at offset is substituted", slog.F("strike", strike),
+			slog.F("substitute", best.Strike), slog.F("reason", reason),
+		)
+		return best, nil
+	}
+	if reason != "" {
+		return nil, xerrors.Errorf("strike %v at offset %v is illiquid, %s", strike, setting.StrikeOffset, reason)
+	}
+	return nil, xerrors.Errorf("no strike %v at offset %v", strike, setting.StrikeOffset)
+}
+
+// illiquidReason returns why the option fails the liquidity, empty if it passes or liquidity is unset
+func illiquidReason(option *datasourcev1.Option, liquidity *botv1.Liquidity, now time.Time) string {
+	if liquidity == nil {
+		return ""
+	}
+	spread := option.Ask - option.Bid
+	if liquidity.MaxSpread > 0 && spread > liquidity.MaxSpread+matchTolerance {
+		return fmt.Sprintf("spread %.2f is over %.2f", spread, liquidity.MaxSpread)
+	}
+	if liquidity.MaxSpreadPercent > 0 {
+		if m := mid(option); m <= 0 || spread/m*100 > liquidity.MaxSpreadPercent {
+			return fmt.Sprintf("spread %.2f of mid %.2f is over %v%%", spread, m, liquidity.MaxSpreadPercent)
+		}
+	}
+	if option.Bid < liquidity.MinBid {
+		return fmt.Sprintf("bid %.2f is under %.2f", option.Bid, liquidity.MinBid)
+	}
+	if option.OpenInterest < liquidity.MinOpenInterest {
+		return fmt.Sprintf("open interest %d is under %d", option.OpenInterest, liquidity.MinOpenInterest)
+	}
+	if option.Volume < liquidity.MinVolume {
+		return fmt.Sprintf("volume %d is under %d", option.Volume, liquidity.MinVolume)
+	}
+	if liquidity.MaxQuoteAge > 0 {
+		maxAge := time.Duration(liquidity.MaxQuoteAge) * time.Second
+		// a quote without any time can't be proven fresh
+		if option.BidAt == 0 && option.AskAt == 0 && option.QuoteAt == 0 {
+			return "quote has no time"
+		}
+		// brokers without the times of the bid and the ask fall back to the time of the quote
+		for _, at := range []int64{option.BidAt, option.AskAt} {
+			if at == 0 {
+				at = option.QuoteAt
+			}
+			if at > 0 && now.Sub(time.UnixMilli(at)) > maxAge {
+				return fmt.Sprintf("quote at %s is older than %s", time.UnixMilli(at).Format(time.TimeOnly), maxAge)
+			}
+		}
+	}
+	return ""
+}
+
+// matchIndex returns the index of the value matching the target best, -1 if none,
+// MATCH_UNSPECIFIED is treated as MATCH_NEAREST
+func matchIndex(values []float64, target float64, match botv1.Match) int {
+	best := -1
+	for i, value := range values {
+		diff := value - target
+		switch match {
+		case botv1.Match_MATCH_EXACT:
+			if math.Abs(diff) > matchTolerance {
+				continue
+			}
+		case botv1.Match_MATCH_AT_LEAST:
+			if diff < -matchTolerance {
+				continue
+			}
+		case botv1.Match_MATCH_AT_MOST:
+			if diff > matchTolerance {
+				continue
+			}
+		}
+		if best < 0 || math.Abs(diff) < math.Abs(values[best]-target) {
+			best = i
+		}
+	}
+	return best
+}
+
+// inIntRange is true if the range is unset or value is in it, a zero max means no upper bound
+func inIntRange(value int, r *botv1.IntRange) bool {
+	if r == nil {
+		return true
+	}
+	return value >= int(r.Min) && (r.Max == 0 || value <= int(r.Max))
+}
+
+// inDoubleRange is true if the range is unset or value is in it, a zero max means no upper bound
+func inDoubleRange(value float64, r *botv1.DoubleRange) bool {
+	if r == nil {
+		return true
+	}
+	return value >= r.Min && (r.Max == 0 || value <= r.Max)
+}
+
+func mid(option *datasourcev1.Option) float64 {
+	return (option.Bid + option.Ask) / 2
+}
+
+// allocate decides the number of units by the allocation and the max loss in dollars of one unit
+func allocate(allocation *botv1.Allocation, unitMaxLoss float64) (int32, error) {
+	switch allocation.GetAllocator() {
+	case botv1.Allocator_ALLOCATOR_UNSPECIFIED:
+		return 1, nil
+	case botv1.Allocator_ALLOCATOR_CONSTANT:
+		if allocation.ConstantSize <= 0 {
+			return 0, xerrors.New("constant size must be positive")
+		}
+		return allocation.ConstantSize, nil
+	case botv1.Allocator_ALLOCATOR_MAX_RISK:
+		if math.IsInf(unitMaxLoss, 1) {
+			return 0, xerrors.New("max risk allocation requires a defined risk position")
+		}
+		if unitMaxLoss <= 0 {
+			return 0, xerrors.New("position has no risk to allocate by")
+		}
+		size := int32(allocation.MaxRisk / unitMaxLoss)
+		if size <= 0 {
+			return 0, xerrors.Errorf(
+				"max risk %v is less than the max loss %v of one unit", allocation.MaxRisk, unitMaxLoss,
+			)
+		}
+		return size, nil
+	}
+	return 0, xerrors.Errorf("unsupported allocator: %s", allocation.GetAllocator())
+}
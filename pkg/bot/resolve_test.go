@@ -0,0 +1,212 @@
+package bot
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeMarket struct {
+	account.Market
+}
+
+func (m *fakeMarket) GetOptionExpirations(ctx context.Context, underlying string) ([]string, error) {
+	return []string{"2024-01-17", "2024-01-19", "2024-01-26"}, nil
+}
+
+func (m *fakeMarket) GetOptionChains(
+	ctx context.Context, underlying string, expiration string,
+) ([]*datasourcev1.Chain, error) {
+	return []*datasourcev1.Chain{
+		{RootSymbol: "SPX", Underlying: "SPX", Expiration: expiration},
+		{
+			RootSymbol: "SPXW",
+			Underlying: "SPX",
+			Expiration: expiration,
+			Puts: []*datasourcev1.Option{
+				{Symbol: "P4600", Strike: 4600, Bid: 0.9, Ask: 1.1, Delta: -0.05},
+				{Symbol: "P4650", Strike: 4650, Bid: 1.9, Ask: 2.1, Delta: -0.1},
+				{Symbol: "P4700", Strike: 4700, Bid: 3.9, Ask: 4.1, Delta: -0.18},
+			},
+		},
+	}, nil
+}
+
+func (m *fakeMarket) GetQuotes(ctx context.Context, symbols []string) (
+	[]*datasourcev1.Quote, error,
+) {
+	return []*datasourcev1.Quote{
+		{Symbol: "SPX", Last: 4750, Open: 4810, PrevClose: 4800},
+		{Symbol: "VIX", Last: 18},
+	}, nil
+}
+
+func TestResolve(t *testing.T) {
+	// a put credit spread of the 0.16 delta put and 50 points below, at least 2 DTE
+	dte := &botv1.DTE{Match: botv1.Match_MATCH_AT_LEAST, Dte: 2}
+	setting := &botv1.Setting{
+		Underlying: "SPX",
+		Legs: []*botv1.Leg{
+			{
+				Action:     botv1.Action_ACTION_SHORT,
+				OptionType: botv1.OptionType_OPTION_TYPE_PUT,
+				Quantity:   1,
+				Strike: &botv1.Strike{
+					Chooser: botv1.StrikeChooser_STRIKE_CHOOSER_DELTA,
+					Match:   botv1.Match_MATCH_NEAREST,
+					Delta:   0.16,
+				},
+				Dte: dte,
+			},
+			{
+				Action:     botv1.Action_ACTION_LONG,
+				OptionType: botv1.OptionType_OPTION_TYPE_PUT,
+				Quantity:   1,
+				Strike: &botv1.Strike{
+					Chooser:      botv1.StrikeChooser_STRIKE_CHOOSER_DELTA,
+					Delta:        0.16,
+					StrikeOffset: -50,
+				},
+				Dte: dte,
+			},
+		},
+		Allocation: &botv1.Allocation{
+			Allocator: botv1.Allocator_ALLOCATOR_MAX_RISK,
+			MaxRisk:   10000,
+		},
+	}
+	now := time.Date(2024, 1, 16, 10, 0, 0, 0, util.TZNewYork)
+	proposal, err := Resolve(context.Background(), &fakeMarket{}, setting, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-19", proposal.Legs[0].Expiration)
+	assert.Equal(t, "SPXW", proposal.Legs[0].RootSymbol)
+	assert.Equal(t, "P4700", proposal.Legs[0].Option.Symbol)
+	assert.Equal(t, "P4650", proposal.Legs[1].Option.Symbol)
+	// a credit of 2, the max loss of one unit is (50 - 2) * 100
+	assert.InDelta(t, -2, proposal.Price, 1e-9)
+	assert.Equal(t, int32(2), proposal.Size)
+	assert.InDelta(t, 9600, proposal.MaxLoss, 1e-6)
+	assert.InDelta(t, 2*4700*100, proposal.Notional, 1e-6)
+
+	order := proposal.Order(0.05)
+	assert.InDelta(t, -2, order.Price, 1e-9)
+	assert.Equal(t, account.SellToOpen, order.Legs[0].Side)
+	assert.Equal(t, int32(2), order.Legs[1].Quantity)
+
+	// the max loss of a naked short put is beyond the max risk
+	setting.Legs = setting.Legs[:1]
+	_, err = Resolve(context.Background(), &fakeMarket{}, setting, now)
+	assert.Error(t, err)
+}
+
+func TestChooseOption_Liquidity(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 16, 10, 0, 0, 0, util.TZNewYork)
+	fresh := now.Add(-time.Second).UnixMilli()
+	puts := []*datasourcev1.Option{
+		{Symbol: "P4550", Strike: 4550, Bid: 0.5, Ask: 0.6, Delta: -0.03, OpenInterest: 900, BidAt: fresh, AskAt: fresh},
+		{Symbol: "P4600", Strike: 4600, Bid: 0.9, Ask: 1.1, Delta: -0.05, OpenInterest: 50, BidAt: fresh, AskAt: fresh},
+		{Symbol: "P4650", Strike: 4650, Bid: 1.9, Ask: 2.1, Delta: -0.1, OpenInterest: 800, BidAt: fresh, AskAt: fresh},
+		// a 5 dollars wide market
+		{Symbol: "P4700", Strike: 4700, Bid: 1, Ask: 6, Delta: -0.18, OpenInterest: 1000, BidAt: fresh, AskAt: fresh},
+	}
+	liquidity := &botv1.Liquidity{MaxSpread: 0.5, MaxSpreadPercent: 20, MinOpenInterest: 100, MaxQuoteAge: 60}
+	setting := &botv1.Strike{
+		Chooser:   botv1.StrikeChooser_STRIKE_CHOOSER_DELTA,
+		Delta:     0.16,
+		Liquidity: liquidity,
+	}
+	// the nearest strike is too wide, fall back to the next best one
+	option, err := chooseOption(ctx, puts, setting, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "P4650", option.Symbol)
+
+	// the wing at the offset has no open interest, fall back to the next strike beyond it
+	setting.StrikeOffset = -50
+	option, err = chooseOption(ctx, puts, setting, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "P4550", option.Symbol)
+
+	// the fallback goes at most one more offset beyond the wing
+	setting.StrikeOffset = -25
+	puts[1].Strike = 4625
+	_, err = chooseOption(ctx, puts, setting, now)
+	assert.ErrorContains(t, err, "is illiquid")
+	setting.StrikeOffset = -50
+	puts[1].Strike = 4600
+
+	// a stale quote is illiquid
+	setting.StrikeOffset = 0
+	puts[2].BidAt = now.Add(-time.Hour).UnixMilli()
+	option, err = chooseOption(ctx, puts, setting, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "P4550", option.Symbol)
+
+	setting.Match = botv1.Match_MATCH_AT_LEAST
+	_, err = chooseOption(ctx, puts, setting, now)
+	assert.ErrorContains(t, err, "3 strikes are illiquid")
+}
+
+func TestChooseOption_SourceLiquidity(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 16, 10, 0, 0, 0, util.TZNewYork)
+	fresh := now.Add(-time.Second).UnixMilli()
+	// the source reports no open interest
+	puts := []*datasourcev1.Option{
+		{Symbol: "P4600", Strike: 4600, Bid: 0.9, Ask: 1.1, Delta: -0.05, BidAt: fresh, AskAt: fresh},
+		{Symbol: "P4650", Strike: 4650, Bid: 1.9, Ask: 2.1, Delta: -0.1},
+	}
+	setting := &botv1.Strike{
+		Chooser:   botv1.StrikeChooser_STRIKE_CHOOSER_DELTA,
+		Delta:     0.1,
+		Liquidity: &botv1.Liquidity{MinOpenInterest: 100, MaxQuoteAge: 60},
+	}
+	// the min open interest is skipped, the quote without any time is stale
+	option, err := chooseOption(ctx, puts, setting, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "P4600", option.Symbol)
+	assert.Equal(t, int64(100), setting.Liquidity.MinOpenInterest)
+}
+
+func TestMaxLoss(t *testing.T) {
+	call := func(strike float64, quantity int32) *ProposalLeg {
+		return &ProposalLeg{
+			Leg:      &botv1.Leg{OptionType: botv1.OptionType_OPTION_TYPE_CALL},
+			Option:   &datasourcev1.Option{Strike: strike},
+			Quantity: quantity,
+		}
+	}
+	// a long call loses at most its debit
+	assert.InDelta(t, 300, maxLoss([]*ProposalLeg{call(100, 1)}, 3), 1e-9)
+	// a naked short call has an unlimited loss
+	assert.True(t, math.IsInf(maxLoss([]*ProposalLeg{call(100, -1)}, -3), 1))
+	// a call credit spread
+	assert.InDelta(t, 800, maxLoss([]*ProposalLeg{call(100, -1), call(110, 1)}, -2), 1e-9)
+}
+
+func TestPayoffCurve(t *testing.T) {
+	put := func(strike float64, quantity int32) *ProposalLeg {
+		return &ProposalLeg{
+			Leg:      &botv1.Leg{OptionType: botv1.OptionType_OPTION_TYPE_PUT},
+			Option:   &datasourcev1.Option{Strike: strike},
+			Quantity: quantity,
+		}
+	}
+	// 2 units of a put credit spread for a credit of 2
+	points := payoffCurve([]*ProposalLeg{put(4700, -1), put(4650, 1)}, -2, 2)
+	// 5% of the lowest strike around the strikes
+	assert.Equal(t, 4417.5, points[0].UnderlyingPrice)
+	assert.InDelta(t, -9600, points[0].Pnl, 1e-6)
+	assert.Equal(t, 4932.5, points[len(points)-1].UnderlyingPrice)
+	assert.InDelta(t, 400, points[len(points)-1].Pnl, 1e-6)
+	for i := 1; i < len(points); i++ {
+		assert.Less(t, points[i-1].UnderlyingPrice, points[i].UnderlyingPrice)
+	}
+}
@@ -0,0 +1,286 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+)
+
+// rollPlan replaces the tested side of a position by new legs
+type rollPlan struct {
+	Reason string
+	// legs of the position to close
+	Close []*botv1.PositionLeg
+	// new legs to open, priced at mid
+	Open []*botv1.PositionLeg
+	// net mid price per share of one unit, positive for debit and negative for credit
+	Price float64
+	// quotes of the new legs by symbol
+	Quotes map[string]*datasourcev1.Option
+}
+
+// maxRolls is the max rolls of a position by the adjustment
+func maxRolls(adjustment *botv1.Adjustment) int32 {
+	if limit := adjustment.GetMaxRolls(); limit > 0 {
+		return limit
+	}
+	return util.Conf.Bot.MaxRolls
+}
+
+// rollCooling is true if the position is rolled too recently to roll again by the adjustment
+func rollCooling(position *botv1.Position, adjustment *botv1.Adjustment, now time.Time) bool {
+	if position.Rolls == 0 {
+		return false
+	}
+	cooldown := util.Conf.Bot.RollCooldown
+	if minutes := adjustment.GetCooldownMinutes(); minutes > 0 {
+		cooldown = time.Duration(minutes) * time.Minute
+	}
+	return now.Sub(time.UnixMilli(position.OpenedAt)) < cooldown
+}
+
+// retested returns why a new leg of the plan would be tested by the adjustment at once, empty if none,
+// such a roll is pointless
+func (p *rollPlan) retested(adjustment *botv1.Adjustment, price float64) string {
+	leg, reason := testedLeg(&botv1.Position{Legs: p.Open}, adjustment, p.Quotes, price)
+	if leg == nil {
+		return ""
+	}
+	return reason
+}
+
+// proposal is the position after the roll as a proposal to check the risk
+func (p *rollPlan) proposal(position *botv1.Position, now time.Time) *Proposal {
+	_, rolled := rolledPosition(position, p, &account.OrderResult{}, now)
+	legs := unitLegs(rolled)
+	return &Proposal{
+		Underlying: rolled.Underlying,
+		Legs:       legs,
+		Price:      rolled.OpenPrice,
+		Size:       rolled.Size,
+		MaxLoss:    maxLoss(legs, rolled.OpenPrice) * float64(rolled.Size),
+		Notional:   positionNotional(rolled),
+	}
+}
+
+// Order builds the roll order of the position, closing and opening legs together at the net price
+// rounded to the tick
+func (p *rollPlan) Order(position *botv1.Position, tick float64) *account.Order {
+	order := &account.Order{
+		Underlying: position.Underlying,
+		Price:      math.Round(p.Price/tick) * tick,
+	}
+	for _, leg := range p.Close {
+		side, quantity := account.SellToClose, leg.Quantity
+		if quantity < 0 {
+			side, quantity = account.BuyToClose, -quantity
+		}
+		order.Legs = append(order.Legs, account.OrderLeg{Symbol: leg.Symbol, Side: side, Quantity: quantity})
+	}
+	for _, leg := range p.Open {
+		side, quantity := account.BuyToOpen, leg.Quantity
+		if quantity < 0 {
+			side, quantity = account.SellToOpen, -quantity
+		}
+		order.Legs = append(order.Legs, account.OrderLeg{Symbol: leg.Symbol, Side: side, Quantity: quantity})
+	}
+	return order
+}
+
+// testedLeg returns the first short leg tested by the adjustment and why, nil if none,
+// price is the underlying price, only used by the distance trigger
+func testedLeg(
+	position *botv1.Position, adjustment *botv1.Adjustment, options map[string]*datasourcev1.Option,
+	price float64,
+) (*botv1.PositionLeg, string) {
+	for _, leg := range position.Legs {
+		if leg.Quantity >= 0 {
+			continue
+		}
+		if limit := adjustment.GetTriggerDelta(); limit > 0 {
+			if delta := math.Abs(options[leg.Symbol].GetDelta()); delta > limit {
+				return leg, fmt.Sprintf("short leg %s delta %.2f exceeds %.2f", leg.Symbol, delta, limit)
+			}
+		}
+		if limit := adjustment.GetTriggerDistance(); limit > 0 && price > 0 {
+			if distance := math.Abs(price - leg.Strike); distance <= limit {
+				return leg, fmt.Sprintf(
+					"underlying %.2f is within %.2f of short leg %s", price, distance, leg.Symbol,
+				)
+			}
+		}
+	}
+	return nil, ""
+}
+
+// planRoll rolls every leg of the tested side to the expiration of the adjustment,
+// with strikes moved away from the underlying by the shift, or the nearest strikes further away
+func planRoll(
+	ctx context.Context, market account.Market, position *botv1.Position, adjustment *botv1.Adjustment,
+	tested *botv1.PositionLeg, options map[string]*datasourcev1.Option, now time.Time,
+) (*rollPlan, error) {
+	expiration := ""
+	if adjustment.GetRollDte() != nil {
+		expirations, err := market.GetOptionExpirations(ctx, position.Underlying)
+		if err != nil {
+			return nil, err
+		}
+		if expiration, err = chooseExpiration(expirations, adjustment.RollDte, now); err != nil {
+			return nil, err
+		}
+	}
+	chains := make(map[string][]*datasourcev1.Chain)
+	plan := &rollPlan{Quotes: make(map[string]*datasourcev1.Option)}
+	for _, leg := range position.Legs {
+		if leg.OptionType != tested.OptionType {
+			continue
+		}
+		to := leg.Expiration
+		if expiration != "" {
+			to = expiration
+		}
+		list, ok := chains[to]
+		if !ok {
+			var err error
+			if list, err = market.GetOptionChains(ctx, position.Underlying, to); err != nil {
+				return nil, err
+			}
+			chains[to] = list
+		}
+		chain := pickChain(list)
+		for _, c := range list {
+			if c.RootSymbol == leg.RootSymbol {
+				chain = c
+				break
+			}
+		}
+		if chain == nil {
+			return nil, xerrors.Errorf("no option chain of %s at %s", position.Underlying, to)
+		}
+		candidates, strike, match := chain.Puts, leg.Strike-adjustment.GetStrikeShift(), botv1.Match_MATCH_AT_MOST
+		if leg.OptionType == botv1.OptionType_OPTION_TYPE_CALL {
+			candidates, strike, match = chain.Calls, leg.Strike+adjustment.GetStrikeShift(), botv1.Match_MATCH_AT_LEAST
+		}
+		tradable := make([]*datasourcev1.Option, 0, len(candidates))
+		strikes := make([]float64, 0, len(candidates))
+		for _, option := range candidates {
+			if option.Ask > 0 {
+				tradable = append(tradable, option)
+				strikes = append(strikes, option.Strike)
+			}
+		}
+		i := matchIndex(strikes, strike, match)
+		if i < 0 {
+			return nil, xerrors.Errorf("no strike to roll %s to %v at %s", leg.Symbol, strike, to)
+		}
+		option := tradable[i]
+		if option.Symbol == leg.Symbol {
+			return nil, xerrors.Errorf("roll doesn't change leg %s", leg.Symbol)
+		}
+		quote, ok := options[leg.Symbol]
+		if !ok {
+			return nil, xerrors.Errorf("no quote of leg %s", leg.Symbol)
+		}
+		closing := proto.Clone(leg).(*botv1.PositionLeg)
+		closing.ClosePrice = mid(quote)
+		plan.Close = append(plan.Close, closing)
+		plan.Open = append(
+			plan.Open, &botv1.PositionLeg{
+				Symbol:     option.Symbol,
+				RootSymbol: chain.RootSymbol,
+				OptionType: leg.OptionType,
+				Strike:     option.Strike,
+				Expiration: to,
+				Quantity:   leg.Quantity,
+				OpenPrice:  mid(option),
+			},
+		)
+		plan.Quotes[option.Symbol] = option
+		plan.Price += float64(leg.Quantity) * (mid(option) - mid(quote))
+	}
+	plan.Price /= float64(position.Size)
+	return plan, nil
+}
+
+// rolledPosition returns the closed position and the new one by the filled roll,
+// the net price of the roll is added to the open price, so the pnl of the new one covers both
+func rolledPosition(
+	position *botv1.Position, plan *rollPlan, result *account.OrderResult, now time.Time,
+) (*botv1.Position, *botv1.Position) {
+	price := plan.Price
+	if result.FillPrice != 0 {
+		price = result.FillPrice
+	}
+	closing := make(map[string]bool, len(plan.Close))
+	for _, leg := range plan.Close {
+		closing[leg.Symbol] = true
+	}
+
+	rolled := proto.Clone(position).(*botv1.Position)
+	rolled.Id = util.NewID()
+	rolled.ParentId = ""
+	rolled.RolledFrom = position.Id
+	rolled.Rolls = position.Rolls + 1
+	rolled.OpenedAt = now.UnixMilli()
+	rolled.OpenPrice = position.OpenPrice + price
+	rolled.Legs = nil
+	for _, leg := range position.Legs {
+		if !closing[leg.Symbol] {
+			rolled.Legs = append(rolled.Legs, proto.Clone(leg).(*botv1.PositionLeg))
+		}
+	}
+	for _, leg := range plan.Open {
+		leg = proto.Clone(leg).(*botv1.PositionLeg)
+		if fill, ok := result.LegFillPrices[leg.Symbol]; ok {
+			leg.OpenPrice = fill
+		}
+		rolled.Legs = append(rolled.Legs, leg)
+	}
+	rolled.MaxLoss = storedMaxLoss(
+		maxLoss(unitLegs(rolled), rolled.OpenPrice) * float64(rolled.Size),
+	)
+
+	closed := proto.Clone(position).(*botv1.Position)
+	closed.Status = botv1.PositionStatus_POSITION_STATUS_CLOSED
+	closed.ClosedAt = now.UnixMilli()
+	closed.CloseReason = fmt.Sprintf("rolled to %s: %s", rolled.Id, plan.Reason)
+	for _, leg := range closed.Legs {
+		if !closing[leg.Symbol] {
+			continue
+		}
+		for _, c := range plan.Close {
+			if c.Symbol == leg.Symbol {
+				leg.ClosePrice = c.ClosePrice
+			}
+		}
+		if fill, ok := result.LegFillPrices[leg.Symbol]; ok {
+			leg.ClosePrice = fill
+		}
+	}
+	return closed, rolled
+}
+
+// unitLegs converts the legs of a position to the legs of one unit for the payoff
+func unitLegs(position *botv1.Position) []*ProposalLeg {
+	legs := make([]*ProposalLeg, 0, len(position.Legs))
+	for _, leg := range position.Legs {
+		legs = append(
+			legs, &ProposalLeg{
+				Leg:        &botv1.Leg{OptionType: leg.OptionType},
+				RootSymbol: leg.RootSymbol,
+				Expiration: leg.Expiration,
+				Option:     &datasourcev1.Option{Symbol: leg.Symbol, Strike: leg.Strike},
+				Quantity:   leg.Quantity / position.Size,
+			},
+		)
+	}
+	return legs
+}
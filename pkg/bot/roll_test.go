@@ -0,0 +1,112 @@
+package bot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoll(t *testing.T) {
+	// 2 units of a put credit spread opened for a credit of 2
+	position := &botv1.Position{
+		Id:         "p1",
+		Underlying: "SPX",
+		Status:     botv1.PositionStatus_POSITION_STATUS_OPEN,
+		OpenPrice:  -2,
+		Size:       2,
+		Legs: []*botv1.PositionLeg{
+			{
+				Symbol: "P4700", RootSymbol: "SPXW", OptionType: botv1.OptionType_OPTION_TYPE_PUT,
+				Strike: 4700, Expiration: "2024-01-19", Quantity: -2, OpenPrice: 3,
+			},
+			{
+				Symbol: "P4650", RootSymbol: "SPXW", OptionType: botv1.OptionType_OPTION_TYPE_PUT,
+				Strike: 4650, Expiration: "2024-01-19", Quantity: 2, OpenPrice: 1,
+			},
+		},
+	}
+	options := map[string]*datasourcev1.Option{
+		"P4700": {Symbol: "P4700", Strike: 4700, Bid: 3.9, Ask: 4.1, Delta: -0.18},
+		"P4650": {Symbol: "P4650", Strike: 4650, Bid: 1.9, Ask: 2.1, Delta: -0.1},
+	}
+	adjustment := &botv1.Adjustment{
+		TriggerDelta: 0.2,
+		RollDte:      &botv1.DTE{Match: botv1.Match_MATCH_AT_LEAST, Dte: 7},
+		StrikeShift:  40,
+	}
+
+	// not tested yet by the delta, but by the distance
+	tested, _ := testedLeg(position, adjustment, options, 4750)
+	assert.Nil(t, tested)
+	adjustment.TriggerDistance = 60
+	tested, reason := testedLeg(position, adjustment, options, 4750)
+	assert.Equal(t, "P4700", tested.GetSymbol())
+	assert.Contains(t, reason, "within")
+
+	now := time.Date(2024, 1, 16, 10, 0, 0, 0, util.TZNewYork)
+	plan, err := planRoll(context.Background(), &fakeMarket{}, position, adjustment, tested, options, now)
+	assert.NoError(t, err)
+	// puts are rolled down to the strikes at most 40 points lower at the next week
+	assert.Len(t, plan.Open, 2)
+	assert.Equal(t, "P4650", plan.Open[0].Symbol)
+	assert.Equal(t, "P4600", plan.Open[1].Symbol)
+	assert.Equal(t, "2024-01-26", plan.Open[0].Expiration)
+	assert.Equal(t, int32(-2), plan.Open[0].Quantity)
+	// buy back 4 - 2, sell 2 - 1, a debit of 1 per unit
+	assert.InDelta(t, 1, plan.Price, 1e-9)
+
+	order := plan.Order(position, 0.05)
+	assert.Len(t, order.Legs, 4)
+	assert.Equal(t, account.BuyToClose, order.Legs[0].Side)
+	assert.Equal(t, account.SellToClose, order.Legs[1].Side)
+	assert.Equal(t, account.SellToOpen, order.Legs[2].Side)
+	assert.Equal(t, account.BuyToOpen, order.Legs[3].Side)
+
+	// the same strike and expiration can't be a roll
+	_, err = planRoll(
+		context.Background(), &fakeMarket{}, position, &botv1.Adjustment{TriggerDelta: 0.1}, tested,
+		options, now,
+	)
+	assert.Error(t, err)
+
+	closed, rolled := rolledPosition(position, plan, &account.OrderResult{FillPrice: 0.9}, now)
+	assert.Equal(t, botv1.PositionStatus_POSITION_STATUS_CLOSED, closed.Status)
+	assert.Zero(t, closed.RealizedPnl)
+	assert.InDelta(t, 4, closed.Legs[0].ClosePrice, 1e-9)
+	assert.Equal(t, "p1", rolled.RolledFrom)
+	assert.Equal(t, int32(1), rolled.Rolls)
+	assert.Equal(t, botv1.PositionStatus_POSITION_STATUS_OPEN, rolled.Status)
+	// the credit of 2 less the debit of the roll
+	assert.InDelta(t, -1.1, rolled.OpenPrice, 1e-9)
+	// (50 - 1.1) * 100 * 2
+	assert.InDelta(t, 9780, rolled.MaxLoss, 1e-6)
+}
+
+func TestRoll_Guards(t *testing.T) {
+	now := time.Date(2024, 1, 16, 10, 0, 0, 0, util.TZNewYork)
+	adjustment := &botv1.Adjustment{TriggerDelta: 0.2}
+	// the default limit is finite, and a rolled position cools down
+	assert.Equal(t, util.Conf.Bot.MaxRolls, maxRolls(adjustment))
+	rolled := &botv1.Position{Rolls: 1, OpenedAt: now.Add(-10 * time.Minute).UnixMilli()}
+	assert.True(t, rollCooling(rolled, adjustment, now))
+	assert.False(t, rollCooling(rolled, &botv1.Adjustment{CooldownMinutes: 5}, now))
+	assert.False(t, rollCooling(&botv1.Position{}, adjustment, now))
+
+	// a new short leg still above the trigger delta is tested at once
+	plan := &rollPlan{
+		Open: []*botv1.PositionLeg{{Symbol: "P4650", Quantity: -1}, {Symbol: "P4600", Quantity: 1}},
+		Quotes: map[string]*datasourcev1.Option{
+			"P4650": {Symbol: "P4650", Delta: -0.25},
+			"P4600": {Symbol: "P4600", Delta: -0.15},
+		},
+	}
+	assert.Contains(t, plan.retested(adjustment, 0), "P4650")
+	plan.Quotes["P4650"].Delta = -0.15
+	assert.Empty(t, plan.retested(adjustment, 0))
+}
@@ -0,0 +1,104 @@
+package bot
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	"golang.org/x/xerrors"
+)
+
+var runLogger = util.DefaultLogger.With(slog.F("bot", "run"))
+
+// Runs keeps every evaluation of bots by the scheduler for post-mortem analysis
+var Runs = util.NewAppendLog[*botv1.Run]("bot_runs")
+
+// ListRuns returns the runs of the bot between from and to in the order of creation,
+// the latest ones if the limit is positive
+func ListRuns(botID string, from int64, to int64, limit int) ([]*botv1.Run, error) {
+	// runs are appended once done, which is their creation plus at most the fill timeout,
+	// so read from the latest and stop once they are created that long before from
+	stopAt := from - util.Conf.Bot.FillTimeout.Milliseconds()
+	var runs []*botv1.Run
+	err := Runs.ReadBackward(
+		func(run *botv1.Run) bool {
+			if run.CreatedAt < stopAt {
+				return false
+			}
+			if (botID == "" || run.BotId == botID) && run.CreatedAt >= from && run.CreatedAt <= to {
+				runs = append(runs, run)
+			}
+			return limit <= 0 || len(runs) < limit
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(runs)
+	slices.SortStableFunc(
+		runs, func(a, b *botv1.Run) int {
+			return cmp.Compare(a.CreatedAt, b.CreatedAt)
+		},
+	)
+	return runs, nil
+}
+
+// openRun opens a position of the bot and records the run with its outcome.
+// An opened position is always returned without error, the trade happened and retrying would
+// open another one, so a failure to record is only logged then, otherwise it's joined to the error.
+func openRun(ctx context.Context, bot *Bot, now time.Time, run *botv1.Run) (*botv1.Position, error) {
+	position, err := Executor.Open(ctx, bot, now, run)
+	switch {
+	case err == nil:
+		run.Outcome = botv1.RunOutcome_RUN_OUTCOME_OPENED
+	case xerrors.Is(err, ErrVetoed):
+		run.Outcome = botv1.RunOutcome_RUN_OUTCOME_VETOED
+	default:
+		run.Outcome = botv1.RunOutcome_RUN_OUTCOME_FAILED
+	}
+	if err != nil {
+		run.Error = err.Error()
+	}
+	if appendErr := Runs.Append(run); appendErr != nil {
+		if position != nil {
+			runLogger.Error(
+				ctx, "failed to record run of opened position", slog.F("run_id", run.Id),
+				slog.F("position_id", position.Id), slog.Error(appendErr),
+			)
+			return position, nil
+		}
+		if err == nil {
+			return nil, xerrors.Errorf("failed to record run: %w", appendErr)
+		}
+		return nil, xerrors.Errorf("%w, and failed to record run: %v", err, appendErr)
+	}
+	return position, err
+}
+
+// recordProposal copies the resolved legs, allocation and pricing of the proposal to the run
+func recordProposal(run *botv1.Run, proposal *Proposal) {
+	run.Chains = proposal.Chains
+	run.Size = proposal.Size
+	run.Price = proposal.Price
+	run.MaxLoss = storedMaxLoss(proposal.MaxLoss)
+	run.Legs = run.Legs[:0]
+	for _, leg := range proposal.Legs {
+		run.Legs = append(
+			run.Legs, &botv1.RunLeg{
+				Symbol:     leg.Option.Symbol,
+				RootSymbol: leg.RootSymbol,
+				OptionType: leg.Leg.GetOptionType(),
+				Strike:     leg.Option.Strike,
+				Expiration: leg.Expiration,
+				Quantity:   leg.Quantity,
+				Bid:        leg.Option.Bid,
+				Ask:        leg.Option.Ask,
+				Delta:      leg.Option.Delta,
+			},
+		)
+	}
+}
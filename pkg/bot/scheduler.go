@@ -2,19 +2,200 @@ package bot
 
 import (
 	"context"
+	"time"
 
 	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/calendar"
+	"github.com/ppaanngggg/option-bot/pkg/datasource"
 	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
 )
 
 var Scheduler = &scheduler{
-	logger: util.DefaultLogger.With(slog.F("bot", "scheduler")),
+	ivSampled: make(map[string]string),
+	logger:    util.DefaultLogger.With(slog.F("bot", "scheduler")),
 }
 
 type scheduler struct {
-	logger slog.Logger
+	// underlying -> the date of its last IV sample, only used by the scheduler goroutine
+	ivSampled map[string]string
+	logger    slog.Logger
 }
 
-func (s *scheduler) Run() {
-	s.logger.Info(context.Background(), "scheduler started")
+// Run opens positions of the auto open bots at their entry times, closes positions of
+// the auto close bots by their exit rules and processes the expirations until ctx is done
+func (s *scheduler) Run(ctx context.Context) {
+	s.logger.Info(ctx, "scheduler started")
+	defer ExitEngine.Wait()
+	ticker := time.NewTicker(util.Conf.Bot.SchedulerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.tick(ctx, now)
+		}
+	}
+}
+
+func (s *scheduler) tick(ctx context.Context, now time.Time) {
+	bots, err := Bots.List(
+		func(b *Bot) bool {
+			return b.EnableAutoOpen || b.EnableAutoClose
+		},
+	)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list bots", slog.Error(err))
+		return
+	}
+	open, err := Positions.ListOpen(nil)
+	if err != nil {
+		s.logger.Error(ctx, "failed to list positions", slog.Error(err))
+		return
+	}
+	if len(bots) == 0 && len(open) == 0 {
+		return
+	}
+	cal := s.loadCalendar(ctx, now)
+	// settlements happen after the close, and expiring positions go first before the exits
+	ExpiryProcessor.Run(ctx, cal, now)
+	if _, ok := cal.CurrentSession(now); !ok {
+		return
+	}
+	// exits keep running while trading is halted
+	ExitEngine.Run(ctx, bots, now)
+	// the IV history must not depend on whether the bots enter
+	s.sampleIV(ctx, bots, cal, now)
+
+	state, err := Control.State()
+	if err != nil {
+		s.logger.Error(ctx, "failed to get trading state", slog.Error(err))
+		return
+	}
+	if state.Halted {
+		return
+	}
+	for _, bot := range bots {
+		if !bot.EnableAutoOpen || !entryDue(bot, cal, now) {
+			continue
+		}
+		s.evaluate(ctx, bot, now)
+	}
+}
+
+// evaluate checks the entry of a due bot and opens a position if it passes, the run is recorded
+func (s *scheduler) evaluate(ctx context.Context, bot *Bot, now time.Time) {
+	run := &botv1.Run{
+		Id:        util.NewID(),
+		BotId:     bot.ID,
+		CreatedAt: now.UnixMilli(),
+	}
+	run.EntryPassed, run.EntryReason = checkEntry(ctx, bot, now)
+	if run.EntryPassed {
+		if _, err := openRun(ctx, bot, now, run); err != nil {
+			s.logger.Error(ctx, "failed to open position", slog.F("bot_id", bot.ID), slog.Error(err))
+		}
+	} else {
+		run.Outcome = botv1.RunOutcome_RUN_OUTCOME_SKIPPED
+		if err := Runs.Append(run); err != nil {
+			s.logger.Error(ctx, "failed to record run", slog.F("bot_id", bot.ID), slog.Error(err))
+		}
+	}
+	// record the entry, so that the bot is evaluated once per entry time
+	if err := Bots.UpdateStatus(
+		bot.ID, func(status *botv1.Status) {
+			status.LastEntryAt = now.UnixMilli()
+			status.LastEntryError = run.Error
+		},
+	); err != nil {
+		s.logger.Error(ctx, "failed to update bot status", slog.F("bot_id", bot.ID), slog.Error(err))
+	}
+}
+
+// sampleIV records the daily IV of the underlyings of the auto open bots with IV conditions,
+// once a day in the time before the close
+func (s *scheduler) sampleIV(ctx context.Context, bots []*Bot, cal *calendar.Calendar, now time.Time) {
+	from, ok := cal.BeforeClose(now, util.Conf.Bot.IVSampleBeforeClose)
+	if !ok || now.Before(from) {
+		return
+	}
+	today := calendar.Date(now)
+	for _, bot := range bots {
+		underlying := bot.Setting.GetUnderlying()
+		if !bot.EnableAutoOpen || !hasIVConditions(bot.Setting.GetEntry()) || s.ivSampled[underlying] == today {
+			continue
+		}
+		ds, err := dataSource(bot)
+		if err == nil {
+			err = sampleIV(ctx, ds, underlying, now)
+		}
+		if err != nil {
+			s.logger.Warn(ctx, "failed to sample IV", slog.F("underlying", underlying), slog.Error(err))
+			continue
+		}
+		s.ivSampled[underlying] = today
+	}
+}
+
+// loadCalendar loads today's session of the global data source, NYSE rules if it's unavailable
+func (s *scheduler) loadCalendar(ctx context.Context, now time.Time) *calendar.Calendar {
+	ds, err := datasource.Global()
+	if err == nil {
+		var cal *calendar.Calendar
+		if cal, err = calendar.Load(ctx, ds, now, now); err == nil {
+			return cal
+		}
+	}
+	s.logger.Warn(ctx, "failed to load calendar, fallback to NYSE", slog.Error(err))
+	return calendar.NYSE()
+}
+
+// entryDue is true in the session once the latest passed entry time of today is not evaluated yet,
+// several missed entry times are evaluated only once
+func entryDue(bot *Bot, cal *calendar.Calendar, now time.Time) bool {
+	if _, ok := cal.CurrentSession(now); !ok {
+		return false
+	}
+	slot, ok := latestEntryTime(bot.Setting.GetEntry(), now)
+	if !ok {
+		return false
+	}
+	return bot.Status.GetLastEntryAt() < slot.UnixMilli()
+}
+
+// latestEntryTime returns the latest entry time of the day of now not after now
+func latestEntryTime(entry *botv1.Entry, now time.Time) (time.Time, bool) {
+	now = now.In(util.TZNewYork)
+	at := func(t *botv1.Time) time.Time {
+		return time.Date(
+			now.Year(), now.Month(), now.Day(), int(t.Hour), int(t.Minute), 0, 0, util.TZNewYork,
+		)
+	}
+	var latest time.Time
+	found := false
+	consider := func(t time.Time) {
+		if !t.After(now) && (!found || t.After(latest)) {
+			latest, found = t, true
+		}
+	}
+	if entry.GetTime() != nil {
+		consider(at(entry.Time))
+	}
+	for _, t := range entry.GetTimes() {
+		consider(at(t))
+	}
+	if interval := entry.GetInterval(); interval.GetFrom() != nil && interval.GetTo() != nil &&
+		interval.EveryMinutes > 0 {
+		from, end := at(interval.From), now
+		if to := at(interval.To); end.After(to) {
+			end = to
+		}
+		if !end.Before(from) {
+			// the last slot of the interval not after now
+			every := time.Duration(interval.EveryMinutes) * time.Minute
+			consider(from.Add(end.Sub(from) / every * every))
+		}
+	}
+	return latest, found
 }
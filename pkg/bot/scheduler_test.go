@@ -0,0 +1,97 @@
+package bot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/calendar"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler_Entry(t *testing.T) {
+	ny := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 1, day, hour, minute, 0, 0, util.TZNewYork)
+	}
+	bot := &Bot{
+		ID: "b1",
+		Setting: &botv1.Setting{
+			Entry: &botv1.Entry{
+				Weekdays: &botv1.WeekdaysChooser{Monday: true, Wednesday: true},
+				Time:     &botv1.Time{Hour: 10, Minute: 30},
+			},
+		},
+	}
+	cal := calendar.NYSE()
+	// Tuesday 2024-01-16
+	assert.False(t, entryDue(bot, cal, ny(16, 10, 0)))
+	assert.True(t, entryDue(bot, cal, ny(16, 10, 30)))
+	assert.False(t, entryDue(bot, cal, ny(16, 16, 30)))
+	passed, reason := checkEntry(context.Background(), bot, ny(16, 10, 30))
+	assert.False(t, passed)
+	assert.Equal(t, "Tuesday is not an entry weekday", reason)
+
+	// evaluated once a day
+	bot.Status = &botv1.Status{LastEntryAt: ny(16, 10, 30).UnixMilli()}
+	assert.False(t, entryDue(bot, cal, ny(16, 11, 0)))
+	assert.True(t, entryDue(bot, cal, ny(17, 11, 0)))
+	passed, _ = checkEntry(context.Background(), bot, ny(17, 11, 0))
+	assert.True(t, passed)
+}
+
+func TestListRuns(t *testing.T) {
+	util.Conf.Storage.Dir = t.TempDir()
+	Runs = util.NewAppendLog[*botv1.Run]("bot_runs")
+	for i, botID := range []string{"b1", "b2", "b1", "b1"} {
+		assert.NoError(t, Runs.Append(&botv1.Run{Id: string(rune('a' + i)), BotId: botID, CreatedAt: int64(i)}))
+	}
+	runs, err := ListRuns("b1", 0, 10, 2)
+	assert.NoError(t, err)
+	assert.Len(t, runs, 2)
+	assert.Equal(t, "c", runs[0].Id)
+	assert.Equal(t, "d", runs[1].Id)
+
+	runs, err = ListRuns("", 1, 2, 0)
+	assert.NoError(t, err)
+	assert.Len(t, runs, 2)
+
+	// a run appended once its order filled is listed in the order of creation
+	assert.NoError(t, Runs.Append(&botv1.Run{Id: "e", BotId: "b2", CreatedAt: 1}))
+	runs, err = ListRuns("b2", 0, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", runs[0].Id)
+	assert.Equal(t, "e", runs[1].Id)
+}
+
+func TestLatestEntryTime(t *testing.T) {
+	ny := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 16, hour, minute, 0, 0, util.TZNewYork)
+	}
+	entry := &botv1.Entry{
+		Times: []*botv1.Time{{Hour: 9, Minute: 45}, {Hour: 15, Minute: 0}},
+		Interval: &botv1.Interval{
+			From:         &botv1.Time{Hour: 10, Minute: 0},
+			To:           &botv1.Time{Hour: 14, Minute: 0},
+			EveryMinutes: 30,
+		},
+	}
+	_, ok := latestEntryTime(entry, ny(9, 40))
+	assert.False(t, ok)
+	at, _ := latestEntryTime(entry, ny(9, 59))
+	assert.Equal(t, ny(9, 45), at)
+	at, _ = latestEntryTime(entry, ny(11, 10))
+	assert.Equal(t, ny(11, 0), at)
+	at, _ = latestEntryTime(entry, ny(14, 50))
+	assert.Equal(t, ny(14, 0), at)
+	at, _ = latestEntryTime(entry, ny(15, 30))
+	assert.Equal(t, ny(15, 0), at)
+
+	// each entry time is evaluated once
+	bot := &Bot{Setting: &botv1.Setting{Entry: entry}}
+	assert.True(t, entryDue(bot, calendar.NYSE(), ny(10, 31)))
+	bot.Status = &botv1.Status{LastEntryAt: ny(10, 31).UnixMilli()}
+	assert.False(t, entryDue(bot, calendar.NYSE(), ny(10, 59)))
+	assert.True(t, entryDue(bot, calendar.NYSE(), ny(11, 0)))
+}
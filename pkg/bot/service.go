@@ -2,12 +2,15 @@ package bot
 
 import (
 	"context"
+	"time"
 
 	"cdr.dev/slog"
 	"connectrpc.com/connect"
+	"github.com/ppaanngggg/option-bot/pkg/account"
 	"github.com/ppaanngggg/option-bot/pkg/util"
 	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
 	"github.com/ppaanngggg/option-bot/proto/gen/bot/v1/botv1connect"
+	"golang.org/x/xerrors"
 )
 
 var Service botv1connect.BotServiceHandler
@@ -25,11 +28,235 @@ type service struct {
 func (s *service) Create(
 	ctx context.Context, c *connect.Request[botv1.CreateRequest],
 ) (*connect.Response[botv1.CreateResponse], error) {
-	panic("implement me")
+	if c.Msg.Name == "" || c.Msg.Setting.GetUnderlying() == "" || len(c.Msg.Setting.GetLegs()) == 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, xerrors.New("name, underlying and legs are required"),
+		)
+	}
+	if _, err := account.Open(c.Msg.AccountId); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if c.Msg.DataAccountId != "" {
+		if _, err := account.Open(c.Msg.DataAccountId); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+	bot := &Bot{
+		ID:              util.NewID(),
+		Name:            c.Msg.Name,
+		AccountID:       c.Msg.AccountId,
+		DataAccountID:   c.Msg.DataAccountId,
+		EnableAutoOpen:  c.Msg.EnableAutoOpen,
+		EnableAutoClose: c.Msg.EnableAutoClose,
+		Setting:         c.Msg.Setting,
+		Status:          &botv1.Status{},
+	}
+	if err := Bots.Save(bot); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.logger.Info(ctx, "bot created", slog.F("id", bot.ID), slog.F("name", bot.Name))
+	return &connect.Response[botv1.CreateResponse]{
+		Msg: &botv1.CreateResponse{
+			Id:              bot.ID,
+			Name:            bot.Name,
+			Setting:         bot.Setting,
+			AccountId:       bot.AccountID,
+			EnableAutoOpen:  bot.EnableAutoOpen,
+			EnableAutoClose: bot.EnableAutoClose,
+			DataAccountId:   bot.DataAccountID,
+		},
+	}, nil
 }
 
 func (s *service) Get(
 	ctx context.Context, c *connect.Request[botv1.GetRequest],
 ) (*connect.Response[botv1.GetResponse], error) {
-	panic("implement me")
+	bot, err := Bots.Get(c.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	return &connect.Response[botv1.GetResponse]{
+		Msg: &botv1.GetResponse{
+			Id:              bot.ID,
+			Name:            bot.Name,
+			Setting:         bot.Setting,
+			AccountId:       bot.AccountID,
+			EnableAutoOpen:  bot.EnableAutoOpen,
+			EnableAutoClose: bot.EnableAutoClose,
+			Status:          bot.Status,
+			DataAccountId:   bot.DataAccountID,
+		},
+	}, nil
+}
+
+func (s *service) TradingControl(
+	ctx context.Context, c *connect.Request[botv1.TradingControlRequest],
+) (*connect.Response[botv1.TradingControlResponse], error) {
+	operator := c.Msg.Operator
+	if operator == "" {
+		operator = c.Peer().Addr
+	}
+	var (
+		state   *botv1.TradingState
+		event   *botv1.TradingControlEvent
+		flatten *botv1.FlattenProgress
+		err     error
+	)
+	switch c.Msg.Action {
+	case botv1.TradingAction_TRADING_ACTION_HALT:
+		state, event, flatten, err = Control.Halt(ctx, operator, c.Msg.Reason, c.Msg.Flatten)
+	case botv1.TradingAction_TRADING_ACTION_RESUME:
+		if c.Msg.Flatten != botv1.FlattenType_FLATTEN_TYPE_UNSPECIFIED {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument, xerrors.New("flatten is only allowed to halt"),
+			)
+		}
+		state, event, err = Control.Resume(ctx, operator, c.Msg.Reason)
+	default:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, xerrors.Errorf("invalid action: %s", c.Msg.Action),
+		)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &connect.Response[botv1.TradingControlResponse]{
+		Msg: &botv1.TradingControlResponse{
+			State:   state,
+			Event:   event,
+			Flatten: flatten,
+		},
+	}, nil
+}
+
+func (s *service) GetTradingState(
+	ctx context.Context, c *connect.Request[botv1.GetTradingStateRequest],
+) (*connect.Response[botv1.GetTradingStateResponse], error) {
+	state, err := Control.State()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	events, err := Control.Events(int(c.Msg.EventLimit))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &connect.Response[botv1.GetTradingStateResponse]{
+		Msg: &botv1.GetTradingStateResponse{
+			State:   state,
+			Events:  events,
+			Flatten: Control.Flatten(),
+		},
+	}, nil
+}
+
+func (s *service) ListRuns(
+	ctx context.Context, c *connect.Request[botv1.ListRunsRequest],
+) (*connect.Response[botv1.ListRunsResponse], error) {
+	to := c.Msg.To
+	if to == 0 {
+		to = time.Now().UnixMilli()
+	}
+	runs, err := ListRuns(c.Msg.BotId, c.Msg.From, to, int(c.Msg.Limit))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &connect.Response[botv1.ListRunsResponse]{
+		Msg: &botv1.ListRunsResponse{
+			Runs: runs,
+		},
+	}, nil
+}
+
+func (s *service) Preview(
+	ctx context.Context, c *connect.Request[botv1.PreviewRequest],
+) (*connect.Response[botv1.PreviewResponse], error) {
+	bot := &Bot{AccountID: c.Msg.AccountId, DataAccountID: c.Msg.DataAccountId, Setting: c.Msg.Setting}
+	if c.Msg.BotId != "" {
+		var err error
+		if bot, err = Bots.Get(c.Msg.BotId); err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+	} else if c.Msg.Setting == nil || c.Msg.AccountId == "" {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, xerrors.New("bot id, or setting and account id are required"),
+		)
+	}
+	preview, err := Preview(ctx, bot, time.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	return &connect.Response[botv1.PreviewResponse]{Msg: preview}, nil
+}
+
+func (s *service) OpenNow(
+	ctx context.Context, c *connect.Request[botv1.OpenNowRequest],
+) (*connect.Response[botv1.OpenNowResponse], error) {
+	bot, err := Bots.Get(c.Msg.BotId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	now := time.Now()
+	run := &botv1.Run{
+		Id:          util.NewID(),
+		BotId:       bot.ID,
+		CreatedAt:   now.UnixMilli(),
+		EntryPassed: true,
+		Manual:      true,
+	}
+	position, err := openRun(ctx, bot, now, run)
+	if err != nil && position != nil {
+		// the position is opened, answer it, a retry would open another one
+		s.logger.Error(ctx, "position opened with an error", slog.F("bot_id", bot.ID), slog.Error(err))
+		err = nil
+	}
+	if err != nil {
+		s.logger.Warn(ctx, "failed to open position now", slog.F("bot_id", bot.ID), slog.Error(err))
+		// the run is recorded, so refer to it for the proposal and risk violations
+		err = xerrors.Errorf("run %s: %w", run.Id, err)
+		if xerrors.Is(err, ErrHalted) || xerrors.Is(err, ErrVetoed) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &connect.Response[botv1.OpenNowResponse]{
+		Msg: &botv1.OpenNowResponse{
+			Run:      run,
+			Position: position,
+		},
+	}, nil
+}
+
+func (s *service) ClosePosition(
+	ctx context.Context, c *connect.Request[botv1.ClosePositionRequest],
+) (*connect.Response[botv1.ClosePositionResponse], error) {
+	position, err := Positions.Get(c.Msg.PositionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	if position.Status != botv1.PositionStatus_POSITION_STATUS_OPEN {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition, xerrors.Errorf("position %s is not open", position.Id),
+		)
+	}
+	if c.Msg.Size < 0 || c.Msg.Size > position.Size {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			xerrors.Errorf("size %d is out of the position size %d", c.Msg.Size, position.Size),
+		)
+	}
+	closed, err := Executor.Close(
+		ctx, position, CloseOptions{
+			Units:    c.Msg.Size,
+			IsMarket: c.Msg.Style == botv1.ExecutionStyle_EXECUTION_STYLE_MARKET,
+			Reason:   "manual",
+		}, time.Now(),
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &connect.Response[botv1.ClosePositionResponse]{
+		Msg: &botv1.ClosePositionResponse{
+			Position: closed,
+		},
+	}, nil
 }
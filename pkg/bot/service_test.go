@@ -0,0 +1,37 @@
+package bot
+
+import (
+	"context"
+	"testing"
+
+	"cdr.dev/slog"
+	"connectrpc.com/connect"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_OpenNow_Halted(t *testing.T) {
+	util.Conf.Storage.Dir = t.TempDir()
+	Bots = &BotStore{store: util.NewFileStore[*Bot]("bots")}
+	Runs = util.NewAppendLog[*botv1.Run]("bot_runs")
+	previous := Control
+	Control = &control{
+		states: util.NewFileStore[*botv1.TradingState]("trading_state"),
+		events: util.NewAppendLog[*botv1.TradingControlEvent]("trading_control"),
+		logger: slog.Make(),
+	}
+	t.Cleanup(func() { Control = previous })
+	assert.NoError(t, Bots.Save(&Bot{ID: "b1", AccountID: "acc", Setting: &botv1.Setting{}}))
+	_, _, _, err := Control.Halt(context.Background(), "alice", "outage", botv1.FlattenType_FLATTEN_TYPE_UNSPECIFIED)
+	assert.NoError(t, err)
+
+	s := &service{logger: slog.Make()}
+	_, err = s.OpenNow(context.Background(), connect.NewRequest(&botv1.OpenNowRequest{BotId: "b1"}))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	assert.ErrorIs(t, err, ErrHalted)
+
+	runs, err := ListRuns("b1", 0, 1<<62, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, botv1.RunOutcome_RUN_OUTCOME_FAILED, runs[0].Outcome)
+}
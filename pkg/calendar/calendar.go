@@ -0,0 +1,276 @@
+package calendar
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+)
+
+// searchLimit bounds the search of the next or previous session
+const searchLimit = 366
+
+// Calendar answers trading day questions in New York time from a list of trade periods,
+// days out of the loaded range are answered by the NYSE holiday rules
+type Calendar struct {
+	periods map[string]*datasourcev1.TradePeriod
+}
+
+func New(periods []*datasourcev1.TradePeriod) *Calendar {
+	c := &Calendar{periods: make(map[string]*datasourcev1.TradePeriod, len(periods))}
+	for _, period := range periods {
+		c.periods[period.Date] = period
+	}
+	return c
+}
+
+// NYSE is a calendar purely by the NYSE holiday rules, e.g. for backtesting
+func NYSE() *Calendar {
+	return New(nil)
+}
+
+// Load fetches the trade periods between from and to of the market
+func Load(ctx context.Context, market account.Market, from time.Time, to time.Time) (
+	*Calendar, error,
+) {
+	periods, err := market.GetTradeCalendar(ctx, Date(from), Date(to))
+	if err != nil {
+		return nil, err
+	}
+	return New(periods), nil
+}
+
+// Date formats t as yyyy-mm-dd in New York
+func Date(t time.Time) string {
+	return t.In(util.TZNewYork).Format("2006-01-02")
+}
+
+// ParseDate parses yyyy-mm-dd as the midnight in New York
+func ParseDate(date string) (time.Time, error) {
+	t, err := time.ParseInLocation("2006-01-02", date, util.TZNewYork)
+	if err != nil {
+		return time.Time{}, xerrors.New(err.Error())
+	}
+	return t, nil
+}
+
+// midnight truncates t to the start of its day in New York
+func midnight(t time.Time) time.Time {
+	t = t.In(util.TZNewYork)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, util.TZNewYork)
+}
+
+// Period returns the trade period of the day of t
+func (c *Calendar) Period(t time.Time) *datasourcev1.TradePeriod {
+	if period, ok := c.periods[Date(t)]; ok {
+		return period
+	}
+	return account.NYSETradePeriod(t)
+}
+
+func (c *Calendar) IsTradingDay(t time.Time) bool {
+	return c.Period(t).IsOpen
+}
+
+// CurrentSession returns the session which is open at t
+func (c *Calendar) CurrentSession(t time.Time) (*datasourcev1.TradePeriod, bool) {
+	period := c.Period(t)
+	if period.IsOpen && period.OpenAt <= t.UnixMilli() && t.UnixMilli() < period.CloseAt {
+		return period, true
+	}
+	return nil, false
+}
+
+// NextSession returns the first session opening after t
+func (c *Calendar) NextSession(t time.Time) (*datasourcev1.TradePeriod, error) {
+	day := midnight(t)
+	for i := 0; i < searchLimit; i++ {
+		period := c.Period(day)
+		if period.IsOpen && period.OpenAt > t.UnixMilli() {
+			return period, nil
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return nil, xerrors.Errorf("no session found after %s", t)
+}
+
+// PreviousSession returns the last session closed at or before t
+func (c *Calendar) PreviousSession(t time.Time) (*datasourcev1.TradePeriod, error) {
+	day := midnight(t)
+	for i := 0; i < searchLimit; i++ {
+		period := c.Period(day)
+		if period.IsOpen && period.CloseAt <= t.UnixMilli() {
+			return period, nil
+		}
+		day = day.AddDate(0, 0, -1)
+	}
+	return nil, xerrors.Errorf("no session found before %s", t)
+}
+
+// TradingDaysBetween counts the trading days after the day of from up to and including the day of to,
+// it's negative if to is before from
+func (c *Calendar) TradingDaysBetween(from time.Time, to time.Time) int {
+	start, end := midnight(from), midnight(to)
+	if end.Before(start) {
+		return -c.TradingDaysBetween(to, from)
+	}
+	count := 0
+	for day := start.AddDate(0, 0, 1); !day.After(end); day = day.AddDate(0, 0, 1) {
+		if c.IsTradingDay(day) {
+			count++
+		}
+	}
+	return count
+}
+
+// DTE returns the calendar days from the day of now to the expiration (yyyy-mm-dd), 0 on the expiration day
+func DTE(now time.Time, expiration string) (int, error) {
+	exp, err := ParseDate(expiration)
+	if err != nil {
+		return 0, err
+	}
+	// count in UTC, since a day across DST is 23 or 25 hours in New York
+	today := midnight(now)
+	from := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(exp.Year(), exp.Month(), exp.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24), nil
+}
+
+// BusinessDTE returns the trading days from the day of now to the expiration (yyyy-mm-dd),
+// 0 on the expiration day
+func (c *Calendar) BusinessDTE(now time.Time, expiration string) (int, error) {
+	exp, err := ParseDate(expiration)
+	if err != nil {
+		return 0, err
+	}
+	return c.TradingDaysBetween(now, exp), nil
+}
+
+// Anchor is the point of a session relative times are based on
+type Anchor int
+
+const (
+	AnchorOpen Anchor = iota
+	AnchorClose
+)
+
+// SessionTime returns the anchor of the session of the day of t shifted by offset,
+// e.g. SessionTime(t, AnchorClose, -15*time.Minute) is 15 minutes before close,
+// false if the market is closed that day
+func (c *Calendar) SessionTime(t time.Time, anchor Anchor, offset time.Duration) (time.Time, bool) {
+	period := c.Period(t)
+	if !period.IsOpen {
+		return time.Time{}, false
+	}
+	at := period.OpenAt
+	if anchor == AnchorClose {
+		at = period.CloseAt
+	}
+	return time.UnixMilli(at).In(util.TZNewYork).Add(offset), true
+}
+
+// AfterOpen is SessionTime(t, AnchorOpen, d)
+func (c *Calendar) AfterOpen(t time.Time, d time.Duration) (time.Time, bool) {
+	return c.SessionTime(t, AnchorOpen, d)
+}
+
+// BeforeClose is SessionTime(t, AnchorClose, -d)
+func (c *Calendar) BeforeClose(t time.Time, d time.Duration) (time.Time, bool) {
+	return c.SessionTime(t, AnchorClose, -d)
+}
+
+type Settlement int
+
+const (
+	// settled by the closing prices of the expiration day
+	SettlementPM Settlement = iota
+	// settled by the opening prices of the expiration day, the last trading day is the day before
+	SettlementAM
+)
+
+// amSettledRoots are the AM settled index option roots, their weekly or P roots are PM settled,
+// e.g. SPX vs SPXW, NDX vs NDXP, RUT vs RUTW, all VIX options are AM settled
+var amSettledRoots = map[string]bool{
+	"SPX":  true,
+	"NDX":  true,
+	"RUT":  true,
+	"DJX":  true,
+	"MXEA": true,
+	"MXEF": true,
+	"VIX":  true,
+	"VIXW": true,
+}
+
+// SettlementOf returns the settlement style of an option root symbol
+func SettlementOf(root string) Settlement {
+	if amSettledRoots[strings.ToUpper(root)] {
+		return SettlementAM
+	}
+	return SettlementPM
+}
+
+// cashSettledRoots are the index option roots settled in cash, others are equity or ETF options
+// settled by delivering the shares, which may be assigned
+var cashSettledRoots = map[string]bool{
+	"SPX":  true,
+	"SPXW": true,
+	"XSP":  true,
+	"NDX":  true,
+	"NDXP": true,
+	"XND":  true,
+	"RUT":  true,
+	"RUTW": true,
+	"DJX":  true,
+	"MXEA": true,
+	"MXEF": true,
+	"VIX":  true,
+	"VIXW": true,
+}
+
+// IsCashSettled is true if options of the root settle in cash
+func IsCashSettled(root string) bool {
+	return cashSettledRoots[strings.ToUpper(root)]
+}
+
+// SettlementTime returns when an option of the root expiring on the expiration (yyyy-mm-dd) settles,
+// the open of the expiration day for AM settled ones and the close for PM settled ones
+func (c *Calendar) SettlementTime(root string, expiration string) (time.Time, error) {
+	exp, err := ParseDate(expiration)
+	if err != nil {
+		return time.Time{}, err
+	}
+	anchor := AnchorClose
+	if SettlementOf(root) == SettlementAM {
+		anchor = AnchorOpen
+	}
+	at, ok := c.SessionTime(exp, anchor, 0)
+	if !ok {
+		return time.Time{}, xerrors.Errorf("market is closed on expiration %s", expiration)
+	}
+	return at, nil
+}
+
+// LastTradeTime returns when an option of the root expiring on the expiration (yyyy-mm-dd) stops trading,
+// the close of the day before for AM settled ones and the close of the expiration day for PM settled ones
+func (c *Calendar) LastTradeTime(root string, expiration string) (time.Time, error) {
+	exp, err := ParseDate(expiration)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if SettlementOf(root) == SettlementAM {
+		period, err := c.PreviousSession(exp)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.UnixMilli(period.CloseAt).In(util.TZNewYork), nil
+	}
+	at, ok := c.SessionTime(exp, AnchorClose, 0)
+	if !ok {
+		return time.Time{}, xerrors.Errorf("market is closed on expiration %s", expiration)
+	}
+	return at, nil
+}
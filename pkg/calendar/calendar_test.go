@@ -0,0 +1,93 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func ny(year int, month time.Month, day, hour, minute int) time.Time {
+	return time.Date(year, month, day, hour, minute, 0, 0, util.TZNewYork)
+}
+
+func TestCalendar_Sessions(t *testing.T) {
+	c := NYSE()
+
+	// Thursday before Good Friday 2024, after close
+	next, err := c.NextSession(ny(2024, 3, 28, 17, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-04-01", next.Date)
+
+	prev, err := c.PreviousSession(ny(2024, 4, 1, 10, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-03-28", prev.Date)
+
+	_, ok := c.CurrentSession(ny(2024, 4, 1, 10, 0))
+	assert.True(t, ok)
+	_, ok = c.CurrentSession(ny(2024, 4, 1, 16, 0))
+	assert.False(t, ok)
+
+	// across Good Friday and a weekend
+	assert.Equal(t, 1, c.TradingDaysBetween(ny(2024, 3, 28, 0, 0), ny(2024, 4, 1, 0, 0)))
+	assert.Equal(t, -1, c.TradingDaysBetween(ny(2024, 4, 1, 0, 0), ny(2024, 3, 28, 0, 0)))
+}
+
+func TestCalendar_DTE(t *testing.T) {
+	c := NYSE()
+	// across the DST change on 2024-03-10
+	dte, err := DTE(ny(2024, 3, 8, 15, 0), "2024-03-15")
+	assert.NoError(t, err)
+	assert.Equal(t, 7, dte)
+	dte, err = c.BusinessDTE(ny(2024, 3, 8, 15, 0), "2024-03-15")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, dte)
+	dte, err = DTE(ny(2024, 3, 15, 9, 0), "2024-03-15")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, dte)
+}
+
+func TestCalendar_SessionTime(t *testing.T) {
+	// a loaded period overrides the NYSE rules
+	c := New(
+		[]*datasourcev1.TradePeriod{
+			{
+				Date:    "2024-11-29",
+				IsOpen:  true,
+				OpenAt:  ny(2024, 11, 29, 9, 30).UnixMilli(),
+				CloseAt: ny(2024, 11, 29, 13, 0).UnixMilli(),
+			},
+		},
+	)
+	at, ok := c.BeforeClose(ny(2024, 11, 29, 0, 0), 15*time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, ny(2024, 11, 29, 12, 45), at)
+	at, ok = c.AfterOpen(ny(2024, 12, 2, 0, 0), 30*time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, ny(2024, 12, 2, 10, 0), at)
+	_, ok = c.BeforeClose(ny(2024, 11, 28, 0, 0), 15*time.Minute)
+	assert.False(t, ok)
+}
+
+func TestCalendar_Settlement(t *testing.T) {
+	c := NYSE()
+	assert.Equal(t, SettlementAM, SettlementOf("SPX"))
+	assert.Equal(t, SettlementPM, SettlementOf("SPXW"))
+	assert.Equal(t, SettlementPM, SettlementOf("SPY"))
+	assert.True(t, IsCashSettled("SPXW"))
+	assert.False(t, IsCashSettled("SPY"))
+
+	at, err := c.SettlementTime("SPX", "2024-03-15")
+	assert.NoError(t, err)
+	assert.Equal(t, ny(2024, 3, 15, 9, 30), at)
+	at, err = c.SettlementTime("SPXW", "2024-03-15")
+	assert.NoError(t, err)
+	assert.Equal(t, ny(2024, 3, 15, 16, 0), at)
+
+	// AM settled options stop trading at the close of the day before
+	at, err = c.LastTradeTime("SPX", "2024-03-15")
+	assert.NoError(t, err)
+	assert.Equal(t, ny(2024, 3, 14, 16, 0), at)
+}
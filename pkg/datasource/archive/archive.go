@@ -0,0 +1,146 @@
+package archive
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Archive stores option chain snapshots on local disk, one file per underlying and quote date,
+// e.g. <dir>/SPX/2024-01-02.jsonl, each line is a protojson encoded datasourcev1.Chain
+type Archive struct {
+	dir string
+}
+
+func NewArchive(dir string) *Archive {
+	return &Archive{dir: dir}
+}
+
+func (a *Archive) path(underlying string, quoteDate string) string {
+	return filepath.Join(a.dir, underlying, quoteDate+".jsonl")
+}
+
+// Append appends chains quoted on quoteDate (yyyy-mm-dd) to the archive of their underlying,
+// a chain already archived with the same root, expiration and quote time is replaced,
+// so that importing a file twice doesn't duplicate the snapshots
+func (a *Archive) Append(quoteDate string, chains []*datasourcev1.Chain) error {
+	// group by underlying, so that each file is opened only once
+	groups := make(map[string][]*datasourcev1.Chain)
+	for _, chain := range chains {
+		if chain.Underlying == "" {
+			return xerrors.Errorf("chain without underlying, root symbol: %s", chain.RootSymbol)
+		}
+		groups[chain.Underlying] = append(groups[chain.Underlying], chain)
+	}
+	for underlying, group := range groups {
+		existing, err := a.Read(underlying, quoteDate)
+		if err != nil {
+			return err
+		}
+		replaced := make(map[string]bool, len(group))
+		for _, chain := range group {
+			replaced[chainKey(chain)] = true
+		}
+		kept := slices.DeleteFunc(
+			existing, func(chain *datasourcev1.Chain) bool {
+				return replaced[chainKey(chain)]
+			},
+		)
+		chains := append(kept, group...)
+		// keep the snapshots in time order when an earlier time is imported later
+		slices.SortStableFunc(
+			chains, func(a, b *datasourcev1.Chain) int {
+				return cmp.Compare(chainQuoteAt(a), chainQuoteAt(b))
+			},
+		)
+		if err := a.writeFile(a.path(underlying, quoteDate), chains); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chainKey identifies a snapshot of a chain by its root, expiration and quote time
+func chainKey(chain *datasourcev1.Chain) string {
+	return chain.RootSymbol + "|" + chain.Expiration + "|" + strconv.FormatInt(chainQuoteAt(chain), 10)
+}
+
+// chainQuoteAt is the latest quote time of the options in the chain
+func chainQuoteAt(chain *datasourcev1.Chain) int64 {
+	var quoteAt int64
+	for _, options := range [][]*datasourcev1.Option{chain.Calls, chain.Puts} {
+		for _, o := range options {
+			quoteAt = max(quoteAt, o.QuoteAt)
+		}
+	}
+	return quoteAt
+}
+
+// writeFile replaces the file by chains, through a temp file so that a failure keeps the old one
+func (a *Archive) writeFile(path string, chains []*datasourcev1.Chain) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return xerrors.New(err.Error())
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return xerrors.New(err.Error())
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	for _, chain := range chains {
+		line, err := protojson.Marshal(chain)
+		if err != nil {
+			return xerrors.New(err.Error())
+		}
+		if _, err := w.Write(append(line, '\n')); err != nil {
+			return xerrors.New(err.Error())
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return xerrors.New(err.Error())
+	}
+	if err := f.Close(); err != nil {
+		return xerrors.New(err.Error())
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return xerrors.New(err.Error())
+	}
+	return nil
+}
+
+// Read returns all chains of the underlying quoted on quoteDate (yyyy-mm-dd),
+// an empty list is returned if nothing was archived for that day
+func (a *Archive) Read(underlying string, quoteDate string) ([]*datasourcev1.Chain, error) {
+	f, err := os.Open(a.path(underlying, quoteDate))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	defer f.Close()
+	var chains []*datasourcev1.Chain
+	scanner := bufio.NewScanner(f)
+	// a chain of SPX with greeks can easily exceed the default 64KB line limit
+	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
+	for scanner.Scan() {
+		chain := &datasourcev1.Chain{}
+		if err := protojson.Unmarshal(scanner.Bytes(), chain); err != nil {
+			return nil, xerrors.New(err.Error())
+		}
+		chains = append(chains, chain)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	return chains, nil
+}
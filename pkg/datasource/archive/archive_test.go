@@ -0,0 +1,30 @@
+package archive
+
+import (
+	"testing"
+
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchive_Append(t *testing.T) {
+	chain := func(quoteAt int64, bid float64) *datasourcev1.Chain {
+		return &datasourcev1.Chain{
+			Underlying: "SPX",
+			RootSymbol: "SPXW",
+			Expiration: "2024-01-02",
+			Calls:      []*datasourcev1.Option{{Strike: 4750, Bid: bid, QuoteAt: quoteAt}},
+		}
+	}
+	a := NewArchive(t.TempDir())
+	assert.NoError(t, a.Append("2024-01-02", []*datasourcev1.Chain{chain(1, 3.1)}))
+	assert.NoError(t, a.Append("2024-01-02", []*datasourcev1.Chain{chain(2, 3.2)}))
+	// importing the same quote time again replaces the archived chain
+	assert.NoError(t, a.Append("2024-01-02", []*datasourcev1.Chain{chain(1, 3.3)}))
+
+	chains, err := a.Read("SPX", "2024-01-02")
+	assert.NoError(t, err)
+	assert.Len(t, chains, 2)
+	assert.Equal(t, 3.3, chains[0].Calls[0].Bid)
+	assert.Equal(t, 3.2, chains[1].Calls[0].Bid)
+}
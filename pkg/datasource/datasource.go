@@ -4,4 +4,21 @@ import "github.com/ppaanngggg/option-bot/pkg/account"
 
 type DataSource struct {
 	account.Market
+	composite *account.CompositeMarket
+}
+
+// NewDataSource fails over between the sources in order, and falls back to the NYSE calendar when
+// all of them fail to answer the calendar. The market of every source should be the CachedMarket
+// of its account, shared by all data sources, since the request limits are per account.
+func NewDataSource(sources []account.Source, opts account.CompositeOptions) *DataSource {
+	composite := account.NewCompositeMarket(sources, opts)
+	return &DataSource{
+		Market:    account.NewCalendarFallback(composite),
+		composite: composite,
+	}
+}
+
+// Health returns the health of every source in the order of failover
+func (d *DataSource) Health() []account.SourceHealth {
+	return d.composite.Health()
 }
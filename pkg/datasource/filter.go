@@ -0,0 +1,109 @@
+package datasource
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	v1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// needsPrice reports whether the filter needs the price of the underlying
+func needsPrice(filter *v1.ChainFilter) bool {
+	return filter.GetStrikesAroundAtm() > 0
+}
+
+// filterChains drops the chains and options not matching the filter, chains are modified in place,
+// price is the underlying price for the strikes around ATM
+func filterChains(chains []*v1.Chain, filter *v1.ChainFilter, price float64) []*v1.Chain {
+	if filter == nil {
+		return chains
+	}
+	filtered := make([]*v1.Chain, 0, len(chains))
+	for _, chain := range chains {
+		if len(filter.Roots) > 0 && !slices.Contains(filter.Roots, chain.RootSymbol) {
+			continue
+		}
+		if filter.Side == v1.OptionSide_OPTION_SIDE_PUT {
+			chain.Calls = nil
+		} else {
+			chain.Calls = filterOptions(chain.Calls, filter, price)
+		}
+		if filter.Side == v1.OptionSide_OPTION_SIDE_CALL {
+			chain.Puts = nil
+		} else {
+			chain.Puts = filterOptions(chain.Puts, filter, price)
+		}
+		filtered = append(filtered, chain)
+	}
+	return filtered
+}
+
+func filterOptions(options []*v1.Option, filter *v1.ChainFilter, price float64) []*v1.Option {
+	lower, upper := filter.MinStrike, filter.MaxStrike
+	if n := int(filter.StrikesAroundAtm); n > 0 && len(options) > 0 {
+		strikes := make([]float64, 0, len(options))
+		for _, o := range options {
+			strikes = append(strikes, o.Strike)
+		}
+		sort.Float64s(strikes)
+		strikes = slices.Compact(strikes)
+		atm := 0
+		for i, strike := range strikes {
+			if math.Abs(strike-price) < math.Abs(strikes[atm]-price) {
+				atm = i
+			}
+		}
+		lower = math.Max(lower, strikes[max(atm-n, 0)])
+		if upper > 0 {
+			upper = math.Min(upper, strikes[min(atm+n, len(strikes)-1)])
+		} else {
+			upper = strikes[min(atm+n, len(strikes)-1)]
+		}
+	}
+	filtered := options[:0]
+	for _, o := range options {
+		if lower > 0 && o.Strike < lower || upper > 0 && o.Strike > upper {
+			continue
+		}
+		delta := math.Abs(o.Delta)
+		if filter.MinAbsDelta > 0 && delta < filter.MinAbsDelta ||
+			filter.MaxAbsDelta > 0 && delta > filter.MaxAbsDelta {
+			continue
+		}
+		if o.OpenInterest < filter.MinOpenInterest || o.Volume < filter.MinVolume {
+			continue
+		}
+		filtered = append(filtered, o)
+	}
+	return filtered
+}
+
+// maskOptions clears the fields of options not in the mask, only top level fields of Option are valid
+func maskOptions(chains []*v1.Chain, mask *fieldmaskpb.FieldMask) error {
+	if len(mask.GetPaths()) == 0 {
+		return nil
+	}
+	if !mask.IsValid(&v1.Option{}) {
+		return xerrors.Errorf("invalid option mask: %v", mask.Paths)
+	}
+	for _, chain := range chains {
+		for _, options := range [][]*v1.Option{chain.Calls, chain.Puts} {
+			for _, o := range options {
+				m := o.ProtoReflect()
+				m.Range(
+					func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+						if !slices.Contains(mask.Paths, string(fd.Name())) {
+							m.Clear(fd)
+						}
+						return true
+					},
+				)
+			}
+		}
+	}
+	return nil
+}
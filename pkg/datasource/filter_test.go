@@ -0,0 +1,71 @@
+package datasource
+
+import (
+	"testing"
+
+	v1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func testChains() []*v1.Chain {
+	puts := make([]*v1.Option, 0)
+	for i, strike := range []float64{4650, 4700, 4725, 4750, 4775, 4800} {
+		puts = append(
+			puts, &v1.Option{Symbol: "P" + string(rune('A'+i)), Strike: strike, Bid: 1, Delta: -0.05 * float64(i+1)},
+		)
+	}
+	return []*v1.Chain{
+		{RootSymbol: "SPX", Puts: []*v1.Option{{Symbol: "SPX", Strike: 4700}}},
+		{RootSymbol: "SPXW", Calls: []*v1.Option{{Symbol: "C", Strike: 4800, Delta: 0.3}}, Puts: puts},
+	}
+}
+
+func TestFilterChains(t *testing.T) {
+	chains := filterChains(
+		testChains(), &v1.ChainFilter{
+			Roots: []string{"SPXW"},
+			Side:  v1.OptionSide_OPTION_SIDE_PUT,
+		}, 0,
+	)
+	assert.Len(t, chains, 1)
+	assert.Empty(t, chains[0].Calls)
+	assert.Len(t, chains[0].Puts, 6)
+
+	// one strike around 4740, and the delta drops the farthest
+	chains = filterChains(
+		testChains(), &v1.ChainFilter{
+			Roots:            []string{"SPXW"},
+			StrikesAroundAtm: 1,
+			MaxAbsDelta:      0.16,
+		}, 4740,
+	)
+	var strikes []float64
+	for _, o := range chains[0].Puts {
+		strikes = append(strikes, o.Strike)
+	}
+	assert.Equal(t, []float64{4725}, strikes)
+	assert.Empty(t, chains[0].Calls)
+
+	chains = filterChains(testChains(), &v1.ChainFilter{MinStrike: 4750, MaxStrike: 4775}, 0)
+	assert.Empty(t, chains[0].Puts)
+	assert.Len(t, chains[1].Puts, 2)
+
+	chains = testChains()
+	chains[1].Puts[2].OpenInterest, chains[1].Puts[2].Volume = 500, 20
+	chains[1].Puts[3].OpenInterest = 800
+	chains = filterChains(chains, &v1.ChainFilter{MinOpenInterest: 100, MinVolume: 10}, 0)
+	assert.Len(t, chains[1].Puts, 1)
+	assert.Equal(t, 4725.0, chains[1].Puts[0].Strike)
+}
+
+func TestMaskOptions(t *testing.T) {
+	chains := testChains()
+	assert.NoError(t, maskOptions(chains, &fieldmaskpb.FieldMask{Paths: []string{"symbol", "strike"}}))
+	put := chains[1].Puts[0]
+	assert.Equal(t, 4650.0, put.Strike)
+	assert.Equal(t, 0.0, put.Bid)
+	assert.Equal(t, 0.0, put.Delta)
+
+	assert.Error(t, maskOptions(chains, &fieldmaskpb.FieldMask{Paths: []string{"unknown"}}))
+}
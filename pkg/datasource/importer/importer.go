@@ -0,0 +1,429 @@
+package importer
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"golang.org/x/xerrors"
+)
+
+// Mapping maps the columns of a vendor csv dump to the fields of datasourcev1.Option,
+// every value is a column name in the csv header, an empty value means the column is absent
+type Mapping struct {
+	// date or datetime of the quote, e.g. 2024-01-02 or 2024-01-02 15:45:00
+	QuoteDate string `json:"quote_date"`
+	// optional time of the quote if the vendor splits date and time, e.g. 15:45:00
+	QuoteTime  string `json:"quote_time"`
+	Underlying string `json:"underlying"`
+	Root       string `json:"root"`
+	Expiration string `json:"expiration"`
+	Strike     string `json:"strike"`
+	// C/P or call/put, case-insensitive
+	OptionType string `json:"option_type"`
+	Bid        string `json:"bid"`
+	BidSize    string `json:"bid_size"`
+	Ask        string `json:"ask"`
+	AskSize    string `json:"ask_size"`
+	IV         string `json:"iv"`
+	Delta      string `json:"delta"`
+	Gamma      string `json:"gamma"`
+	Theta      string `json:"theta"`
+	Vega       string `json:"vega"`
+
+	// layouts tried in order to parse dates and datetimes, all parsed in New York time
+	// unless the layout carries its own zone
+	TimeLayouts []string `json:"time_layouts"`
+	// time of day used when the quote has no time part, e.g. 16:00 for end of day dumps
+	DefaultQuoteTime string `json:"default_quote_time"`
+	// skip invalid rows with a warning instead of failing the whole import
+	SkipInvalid bool `json:"skip_invalid"`
+}
+
+// DefaultMapping matches the CBOE DataShop option quotes layout
+func DefaultMapping() *Mapping {
+	return &Mapping{
+		QuoteDate:        "quote_datetime",
+		Underlying:       "underlying_symbol",
+		Root:             "root",
+		Expiration:       "expiration",
+		Strike:           "strike",
+		OptionType:       "option_type",
+		Bid:              "bid",
+		BidSize:          "bid_size",
+		Ask:              "ask",
+		AskSize:          "ask_size",
+		IV:               "implied_volatility",
+		Delta:            "delta",
+		Gamma:            "gamma",
+		Theta:            "theta",
+		Vega:             "vega",
+		TimeLayouts:      defaultTimeLayouts,
+		DefaultQuoteTime: "16:00",
+	}
+}
+
+var defaultTimeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02",
+	"01/02/2006 15:04:05",
+	"01/02/2006 15:04",
+	"01/02/2006",
+}
+
+// LoadMapping reads a json mapping file, unset fields fall back to DefaultMapping
+func LoadMapping(path string) (*Mapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	mapping := DefaultMapping()
+	if err := json.Unmarshal(data, mapping); err != nil {
+		return nil, xerrors.Errorf("failed to parse mapping %s: %w", path, err)
+	}
+	return mapping, nil
+}
+
+// Snapshot is all chains quoted at the same time
+type Snapshot struct {
+	QuoteDate string // yyyy-mm-dd in New York
+	QuoteAt   time.Time
+	Chains    []*datasourcev1.Chain
+}
+
+type Importer struct {
+	mapping *Mapping
+	logger  slog.Logger
+}
+
+func NewImporter(mapping *Mapping) *Importer {
+	if len(mapping.TimeLayouts) == 0 {
+		mapping.TimeLayouts = defaultTimeLayouts
+	}
+	return &Importer{
+		mapping: mapping,
+		logger:  util.DefaultLogger.With(slog.F("datasource", "importer")),
+	}
+}
+
+// Import parses a csv dump into snapshots sorted by quote time,
+// options are grouped into chains by quote time, root symbol and expiration
+func (i *Importer) Import(ctx context.Context, r io.Reader) ([]*Snapshot, error) {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to read header: %w", err)
+	}
+	cols, err := i.resolveColumns(header)
+	if err != nil {
+		return nil, err
+	}
+
+	type chainKey struct {
+		quoteAt    int64
+		root       string
+		expiration string
+	}
+	chains := make(map[chainKey]*datasourcev1.Chain)
+	skipped := 0
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read line %d: %w", line, err)
+		}
+		row, err := i.parseRow(cols, record)
+		if err != nil {
+			if i.mapping.SkipInvalid {
+				i.logger.Warn(ctx, "skip invalid row", slog.F("line", line), slog.Error(err))
+				skipped++
+				continue
+			}
+			return nil, xerrors.Errorf("invalid row at line %d: %w", line, err)
+		}
+		key := chainKey{
+			quoteAt:    row.quoteAt.UnixMilli(),
+			root:       row.root,
+			expiration: row.expiration,
+		}
+		chain, ok := chains[key]
+		if !ok {
+			chain = &datasourcev1.Chain{
+				RootSymbol: row.root,
+				Underlying: row.underlying,
+				Expiration: row.expiration,
+			}
+			chains[key] = chain
+		}
+		if row.isCall {
+			chain.Calls = append(chain.Calls, row.option)
+		} else {
+			chain.Puts = append(chain.Puts, row.option)
+		}
+	}
+	if skipped > 0 {
+		i.logger.Warn(ctx, "import finished with skipped rows", slog.F("skipped", skipped))
+	}
+
+	// group chains into snapshots by quote time
+	snapshots := make(map[int64]*Snapshot)
+	for key, chain := range chains {
+		account.SortByStrikePrice(chain)
+		snapshot, ok := snapshots[key.quoteAt]
+		if !ok {
+			quoteAt := time.UnixMilli(key.quoteAt).In(util.TZNewYork)
+			snapshot = &Snapshot{
+				QuoteDate: quoteAt.Format("2006-01-02"),
+				QuoteAt:   quoteAt,
+			}
+			snapshots[key.quoteAt] = snapshot
+		}
+		snapshot.Chains = append(snapshot.Chains, chain)
+	}
+	rets := make([]*Snapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		sort.Slice(
+			snapshot.Chains, func(a, b int) bool {
+				if snapshot.Chains[a].Expiration != snapshot.Chains[b].Expiration {
+					return snapshot.Chains[a].Expiration < snapshot.Chains[b].Expiration
+				}
+				return snapshot.Chains[a].RootSymbol < snapshot.Chains[b].RootSymbol
+			},
+		)
+		rets = append(rets, snapshot)
+	}
+	sort.Slice(
+		rets, func(a, b int) bool {
+			return rets[a].QuoteAt.Before(rets[b].QuoteAt)
+		},
+	)
+	return rets, nil
+}
+
+// columns holds the index of each mapped column in the csv header, -1 if absent
+type columns struct {
+	quoteDate, quoteTime, underlying, root, expiration, strike, optionType int
+	bid, bidSize, ask, askSize, iv, delta, gamma, theta, vega              int
+}
+
+func (i *Importer) resolveColumns(header []string) (*columns, error) {
+	index := make(map[string]int, len(header))
+	for idx, name := range header {
+		index[strings.TrimSpace(name)] = idx
+	}
+	var missing []string
+	lookup := func(name string, required bool) int {
+		if name == "" {
+			if required {
+				missing = append(missing, "<unmapped>")
+			}
+			return -1
+		}
+		idx, ok := index[name]
+		if !ok {
+			if required {
+				missing = append(missing, name)
+			}
+			return -1
+		}
+		return idx
+	}
+	m := i.mapping
+	cols := &columns{
+		quoteDate:  lookup(m.QuoteDate, true),
+		quoteTime:  lookup(m.QuoteTime, false),
+		underlying: lookup(m.Underlying, false),
+		root:       lookup(m.Root, true),
+		expiration: lookup(m.Expiration, true),
+		strike:     lookup(m.Strike, true),
+		optionType: lookup(m.OptionType, true),
+		bid:        lookup(m.Bid, false),
+		bidSize:    lookup(m.BidSize, false),
+		ask:        lookup(m.Ask, false),
+		askSize:    lookup(m.AskSize, false),
+		iv:         lookup(m.IV, false),
+		delta:      lookup(m.Delta, false),
+		gamma:      lookup(m.Gamma, false),
+		theta:      lookup(m.Theta, false),
+		vega:       lookup(m.Vega, false),
+	}
+	if len(missing) > 0 {
+		return nil, xerrors.Errorf("missing required columns: %s", strings.Join(missing, ", "))
+	}
+	return cols, nil
+}
+
+type row struct {
+	quoteAt    time.Time
+	underlying string
+	root       string
+	expiration string
+	isCall     bool
+	option     *datasourcev1.Option
+}
+
+// normalizeUnderlying drops the index prefix of vendors, e.g. ^SPX of CBOE or $SPX of Schwab,
+// so that imported chains line up with the live symbols
+func normalizeUnderlying(symbol string) string {
+	return strings.TrimLeft(strings.ToUpper(symbol), "^$.")
+}
+
+func (i *Importer) parseRow(cols *columns, record []string) (*row, error) {
+	cell := func(idx int) string {
+		if idx < 0 || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+	number := func(idx int, name string) (float64, error) {
+		s := cell(idx)
+		if s == "" {
+			return 0, nil
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return 0, xerrors.Errorf("invalid %s: %q", name, s)
+		}
+		return v, nil
+	}
+
+	// quote time, normalized to New York
+	quote := cell(cols.quoteDate)
+	if t := cell(cols.quoteTime); t != "" {
+		quote += " " + t
+	}
+	quoteAt, hasTime, err := i.parseTime(quote)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid quote date: %w", err)
+	}
+	if !hasTime && i.mapping.DefaultQuoteTime != "" {
+		quoteAt, _, err = i.parseTime(
+			quoteAt.Format("2006-01-02") + " " + i.mapping.DefaultQuoteTime,
+		)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid default quote time: %w", err)
+		}
+	}
+	expiration, _, err := i.parseTime(cell(cols.expiration))
+	if err != nil {
+		return nil, xerrors.Errorf("invalid expiration: %w", err)
+	}
+	r := &row{
+		quoteAt:    quoteAt,
+		root:       strings.ToUpper(cell(cols.root)),
+		underlying: normalizeUnderlying(cell(cols.underlying)),
+		expiration: expiration.Format("2006-01-02"),
+	}
+	if r.root == "" {
+		return nil, xerrors.New("empty root symbol")
+	}
+	if r.underlying == "" {
+		r.underlying = r.root
+	}
+	if r.expiration < quoteAt.Format("2006-01-02") {
+		return nil, xerrors.Errorf(
+			"expiration %s is before quote date %s", r.expiration, quoteAt.Format("2006-01-02"),
+		)
+	}
+	switch strings.ToLower(cell(cols.optionType)) {
+	case "c", "call":
+		r.isCall = true
+	case "p", "put":
+		r.isCall = false
+	default:
+		return nil, xerrors.Errorf("invalid option type: %q", cell(cols.optionType))
+	}
+
+	strike, err := number(cols.strike, "strike")
+	if err != nil {
+		return nil, err
+	}
+	if strike <= 0 {
+		return nil, xerrors.Errorf("invalid strike: %v", strike)
+	}
+	values := make(map[string]float64)
+	for _, f := range []struct {
+		name string
+		idx  int
+	}{
+		{"bid", cols.bid}, {"bid_size", cols.bidSize},
+		{"ask", cols.ask}, {"ask_size", cols.askSize},
+		{"iv", cols.iv}, {"delta", cols.delta}, {"gamma", cols.gamma},
+		{"theta", cols.theta}, {"vega", cols.vega},
+	} {
+		if values[f.name], err = number(f.idx, f.name); err != nil {
+			return nil, err
+		}
+	}
+	if values["bid"] < 0 || values["ask"] < 0 {
+		return nil, xerrors.Errorf("negative quote, bid: %v, ask: %v", values["bid"], values["ask"])
+	}
+	if values["ask"] > 0 && values["bid"] > values["ask"] {
+		return nil, xerrors.Errorf("crossed quote, bid: %v, ask: %v", values["bid"], values["ask"])
+	}
+	if values["iv"] < 0 {
+		return nil, xerrors.Errorf("negative iv: %v", values["iv"])
+	}
+
+	quoteAtMs := quoteAt.UnixMilli()
+	r.option = &datasourcev1.Option{
+		Symbol:  OCCSymbol(r.root, expiration, r.isCall, strike),
+		Strike:  strike,
+		Bid:     values["bid"],
+		BidSize: int32(values["bid_size"]),
+		BidAt:   quoteAtMs,
+		Ask:     values["ask"],
+		AskSize: int32(values["ask_size"]),
+		AskAt:   quoteAtMs,
+		QuoteAt: quoteAtMs,
+		Iv:      values["iv"],
+		Delta:   values["delta"],
+		Gamma:   values["gamma"],
+		Vega:    values["vega"],
+		Theta:   values["theta"],
+	}
+	if cols.iv >= 0 || cols.delta >= 0 {
+		r.option.GreeksUpdatedAt = quoteAtMs
+	}
+	return r, nil
+}
+
+// parseTime tries every layout, and reports whether the value carries a time of day
+func (i *Importer) parseTime(value string) (time.Time, bool, error) {
+	for _, layout := range i.mapping.TimeLayouts {
+		t, err := time.ParseInLocation(layout, value, util.TZNewYork)
+		if err == nil {
+			return t.In(util.TZNewYork), strings.Contains(layout, "15"), nil
+		}
+	}
+	return time.Time{}, false, xerrors.Errorf("unrecognized time: %q", value)
+}
+
+// OCCSymbol builds the option symbol without root padding, as Tradier does, e.g. SPXW240119C04700000
+func OCCSymbol(root string, expiration time.Time, isCall bool, strike float64) string {
+	optionType := "P"
+	if isCall {
+		optionType = "C"
+	}
+	return fmt.Sprintf(
+		"%s%s%s%08d", root, expiration.Format("060102"), optionType,
+		int64(math.Round(strike*1000)),
+	)
+}
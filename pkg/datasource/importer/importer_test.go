@@ -0,0 +1,91 @@
+package importer
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const cboeDump = `underlying_symbol,quote_datetime,root,expiration,strike,option_type,bid_size,bid,ask_size,ask,implied_volatility,delta,gamma,theta,vega
+^SPX,2024-01-02 15:45:00,SPXW,2024-01-02,4750,C,10,3.1,12,3.4,0.12,0.35,0.01,-5.1,0.2
+^SPX,2024-01-02 15:45:00,SPXW,2024-01-02,4740,C,10,8.0,12,8.4,0.11,0.55,0.01,-6.0,0.3
+^SPX,2024-01-02 15:45:00,SPXW,2024-01-02,4740,P,10,1.1,12,1.3,0.13,-0.45,0.01,-5.5,0.3
+^SPX,2024-01-02 15:45:00,SPX,2024-01-19,4700,P,5,20.1,5,20.9,0.14,-0.30,0.002,-1.1,2.1
+`
+
+func TestImporter_Import(t *testing.T) {
+	mapping := DefaultMapping()
+	snapshots, err := NewImporter(mapping).Import(context.Background(), strings.NewReader(cboeDump))
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+
+	snapshot := snapshots[0]
+	assert.Equal(t, "2024-01-02", snapshot.QuoteDate)
+	assert.Equal(
+		t, time.Date(2024, 1, 2, 15, 45, 0, 0, util.TZNewYork).UnixMilli(),
+		snapshot.QuoteAt.UnixMilli(),
+	)
+	assert.Len(t, snapshot.Chains, 2)
+
+	// chains are sorted by expiration
+	spxw := snapshot.Chains[0]
+	assert.Equal(t, "SPXW", spxw.RootSymbol)
+	// the index prefix is dropped
+	assert.Equal(t, "SPX", spxw.Underlying)
+	assert.Equal(t, "2024-01-02", spxw.Expiration)
+	assert.Len(t, spxw.Calls, 2)
+	assert.Len(t, spxw.Puts, 1)
+	// calls are sorted by strike
+	assert.Equal(t, 4740.0, spxw.Calls[0].Strike)
+	assert.Equal(t, "SPXW240102C04740000", spxw.Calls[0].Symbol)
+	assert.Equal(t, snapshot.QuoteAt.UnixMilli(), spxw.Calls[0].GreeksUpdatedAt)
+
+	spx := snapshot.Chains[1]
+	assert.Equal(t, "SPX", spx.RootSymbol)
+	assert.Equal(t, -0.30, spx.Puts[0].Delta)
+}
+
+func TestImporter_CustomMapping(t *testing.T) {
+	dump := `date,ticker,exp,k,cp,bid,ask
+01/02/2024,SPY,01/05/2024,470,put,1.00,1.05
+01/02/2024,SPY,01/05/2024,471,call,abc,1.05
+01/02/2024,SPY,12/29/2023,470,call,1.00,1.05
+`
+	mapping := &Mapping{
+		QuoteDate:        "date",
+		Root:             "ticker",
+		Expiration:       "exp",
+		Strike:           "k",
+		OptionType:       "cp",
+		Bid:              "bid",
+		Ask:              "ask",
+		DefaultQuoteTime: "16:00",
+	}
+
+	// invalid rows fail the import by default
+	_, err := NewImporter(mapping).Import(context.Background(), strings.NewReader(dump))
+	assert.Error(t, err)
+
+	mapping.SkipInvalid = true
+	snapshots, err := NewImporter(mapping).Import(context.Background(), strings.NewReader(dump))
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+	assert.Equal(t, 16, snapshots[0].QuoteAt.Hour())
+	assert.Len(t, snapshots[0].Chains, 1)
+	chain := snapshots[0].Chains[0]
+	assert.Equal(t, "SPY", chain.Underlying)
+	assert.Len(t, chain.Puts, 1)
+	assert.Empty(t, chain.Calls)
+	assert.Zero(t, chain.Puts[0].GreeksUpdatedAt)
+}
+
+func TestImporter_MissingColumns(t *testing.T) {
+	_, err := NewImporter(DefaultMapping()).Import(
+		context.Background(), strings.NewReader("root,strike\nSPX,4700\n"),
+	)
+	assert.Error(t, err)
+}
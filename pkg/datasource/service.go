@@ -2,9 +2,16 @@ package datasource
 
 import (
 	"context"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"cdr.dev/slog"
 	"connectrpc.com/connect"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/calendar"
 	"github.com/ppaanngggg/option-bot/pkg/util"
 	v1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
 	"github.com/ppaanngggg/option-bot/proto/gen/datasource/v1/datasourcev1connect"
@@ -14,39 +21,308 @@ import (
 var Service datasourcev1connect.DataSourceServiceHandler
 
 func init() {
+	logger := util.DefaultLogger.With(slog.F("datasource", "service"))
 	Service = &service{
-		logger: util.DefaultLogger.With(slog.F("datasource", "service")),
+		accountDataSources: make(map[string]*DataSource),
+		markets:            make(map[string]*account.CachedMarket),
+		watcher:            newWatcher(util.Conf.DataSource.WatchInterval, logger),
+		logger:             logger,
 	}
+	account.OnClose(Service.(*service).closeAccount)
 }
 
+// keep the sources of the global data source across restarts
+const (
+	globalAccountKey   = "global_account_id"
+	globalFallbacksKey = "global_fallback_account_ids"
+	globalGreeksKey    = "global_greeks_account_id"
+)
+
+var settings = util.NewFileStore[string]("datasource")
+
 type service struct {
+	mu               sync.RWMutex
 	globalDataSource *DataSource
-	logger           slog.Logger
+	greeksAccountID  string
+	// data sources of single accounts, keyed by account id
+	accountDataSources map[string]*DataSource
+	// the cached market of every account, shared by all data sources, keyed by account id
+	markets map[string]*account.CachedMarket
+	watcher *watcher
+	logger  slog.Logger
+}
+
+// market returns the cached market of the account, opening the account once
+func (s *service) market(accountID string) (*account.CachedMarket, error) {
+	s.mu.RLock()
+	market := s.markets[accountID]
+	s.mu.RUnlock()
+	if market != nil {
+		return market, nil
+	}
+	client, err := account.Open(accountID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.markets[accountID]; ok {
+		return existing, nil
+	}
+	market = account.NewCachedMarket(client.Market, account.DefaultCacheOptions())
+	s.markets[accountID] = market
+	return market, nil
+}
+
+// closeAccount drops the market and the data sources of a deleted account,
+// the global data source is reopened from the settings on the next use
+func (s *service) closeAccount(accountID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.markets, accountID)
+	delete(s.accountDataSources, accountID)
+	if s.globalDataSource != nil && slices.ContainsFunc(
+		s.globalDataSource.Health(), func(h account.SourceHealth) bool {
+			return h.Name == accountID
+		},
+	) {
+		s.globalDataSource = nil
+		s.greeksAccountID = ""
+	}
+}
+
+// openDataSource opens the accounts of the sources in order, the greeks account is appended as
+// the last fallback if it isn't a source yet
+func (s *service) openDataSource(accountIDs []string, greeksAccountID string) (*DataSource, error) {
+	if greeksAccountID != "" && !slices.Contains(accountIDs, greeksAccountID) {
+		accountIDs = append(accountIDs, greeksAccountID)
+	}
+	sources := make([]account.Source, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		if slices.ContainsFunc(sources, func(s account.Source) bool { return s.Name == id }) {
+			continue
+		}
+		market, err := s.market(id)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, account.Source{Name: id, Market: market})
+	}
+	opts := account.DefaultCompositeOptions()
+	opts.GreeksSource = greeksAccountID
+	return NewDataSource(sources, opts), nil
 }
 
 func (s *service) SetGlobal(
 	ctx context.Context, c *connect.Request[v1.SetGlobalRequest],
 ) (*connect.Response[v1.SetGlobalResponse], error) {
-	//TODO implement me
-	panic("implement me")
+	accountIDs := append([]string{c.Msg.AccountId}, c.Msg.FallbackAccountIds...)
+	ds, err := s.openDataSource(accountIDs, c.Msg.GreeksAccountId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	for key, value := range map[string]string{
+		globalAccountKey:   c.Msg.AccountId,
+		globalFallbacksKey: strings.Join(c.Msg.FallbackAccountIds, ","),
+		globalGreeksKey:    c.Msg.GreeksAccountId,
+	} {
+		if err := settings.Put(key, value); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	s.mu.Lock()
+	s.globalDataSource = ds
+	s.greeksAccountID = c.Msg.GreeksAccountId
+	s.mu.Unlock()
+	s.logger.Info(
+		ctx, "global data source set",
+		slog.F("account_id", c.Msg.AccountId),
+		slog.F("fallback_account_ids", c.Msg.FallbackAccountIds),
+		slog.F("greeks_account_id", c.Msg.GreeksAccountId),
+	)
+	return &connect.Response[v1.SetGlobalResponse]{
+		Msg: &v1.SetGlobalResponse{},
+	}, nil
+}
+
+// Global returns the global data source for other packages, e.g. bots and risk
+func Global() (*DataSource, error) {
+	return Service.(*service).getGlobal()
 }
 
-func (s *service) checkGlobalDataSource() error {
+// getGlobal returns the global data source, restoring the last one set after a restart
+func (s *service) getGlobal() (*DataSource, error) {
+	s.mu.RLock()
+	ds := s.globalDataSource
+	s.mu.RUnlock()
+	if ds != nil {
+		return ds, nil
+	}
+	accountID, ok, err := settings.Get(globalAccountKey)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, xerrors.New("global data source is not initialized")
+	}
+	accountIDs := []string{accountID}
+	fallbacks, _, err := settings.Get(globalFallbacksKey)
+	if err != nil {
+		return nil, err
+	}
+	if fallbacks != "" {
+		accountIDs = append(accountIDs, strings.Split(fallbacks, ",")...)
+	}
+	greeksAccountID, _, err := settings.Get(globalGreeksKey)
+	if err != nil {
+		return nil, err
+	}
+	ds, err = s.openDataSource(accountIDs, greeksAccountID)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.globalDataSource == nil {
-		return xerrors.New("global data source is not initialized")
+		s.globalDataSource = ds
+		s.greeksAccountID = greeksAccountID
+	}
+	return s.globalDataSource, nil
+}
+
+// ForAccount returns the data source of the account, the global one if accountID is empty
+func ForAccount(accountID string) (*DataSource, error) {
+	return Service.(*service).getForAccount(accountID)
+}
+
+func (s *service) getForAccount(accountID string) (*DataSource, error) {
+	if accountID == "" {
+		return s.getGlobal()
+	}
+	s.mu.RLock()
+	ds := s.accountDataSources[accountID]
+	s.mu.RUnlock()
+	if ds != nil {
+		return ds, nil
+	}
+	ds, err := s.openDataSource([]string{accountID}, "")
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.accountDataSources[accountID]; ok {
+		return existing, nil
+	}
+	s.accountDataSources[accountID] = ds
+	return ds, nil
+}
+
+// dataSourceOf returns the data source requested by an rpc, unknown accounts are invalid arguments
+func (s *service) dataSourceOf(accountID string) (*DataSource, error) {
+	ds, err := s.getForAccount(accountID)
+	if err == nil {
+		return ds, nil
+	}
+	if accountID != "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return nil, connect.NewError(connect.CodeInternal, err)
+}
+
+func (s *service) GetSourceHealth(
+	ctx context.Context, req *connect.Request[v1.GetSourceHealthRequest],
+) (*connect.Response[v1.GetSourceHealthResponse], error) {
+	ds, err := s.getGlobal()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	s.mu.RLock()
+	greeksAccountID := s.greeksAccountID
+	markets := maps.Clone(s.markets)
+	s.mu.RUnlock()
+	msg := &v1.GetSourceHealthResponse{GreeksAccountId: greeksAccountID}
+	for _, h := range ds.Health() {
+		msg.Sources = append(
+			msg.Sources, &v1.SourceHealth{
+				AccountId:           h.Name,
+				Healthy:             h.Healthy,
+				ConsecutiveFailures: h.ConsecutiveFailures,
+				Calls:               h.Calls,
+				Failures:            h.Failures,
+				Stales:              h.Stales,
+				LastError:           h.LastError,
+				LastErrorAt:         unixMilli(h.LastErrorAt),
+				LastSuccessAt:       unixMilli(h.LastSuccessAt),
+				LastStaleAt:         unixMilli(h.LastStaleAt),
+				CoolingUntil:        unixMilli(h.CoolingUntil),
+				Stale:               h.Stale,
+				CacheStats:          cacheStats(markets[h.Name]),
+			},
+		)
+	}
+	return &connect.Response[v1.GetSourceHealthResponse]{Msg: msg}, nil
+}
+
+// unixMilli returns 0 for the zero time
+// cacheStats returns the cache stats of the market sorted by method, nil if it isn't cached
+func cacheStats(market *account.CachedMarket) []*v1.CacheStat {
+	if market == nil {
+		return nil
+	}
+	stats := make([]*v1.CacheStat, 0)
+	for method, stat := range market.Stats() {
+		stats = append(stats, &v1.CacheStat{Method: method, Hits: stat.Hits, Misses: stat.Misses})
+	}
+	slices.SortFunc(
+		stats, func(a, b *v1.CacheStat) int {
+			return strings.Compare(a.Method, b.Method)
+		},
+	)
+	return stats
+}
+
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+// connectError maps the classified broker errors to connect codes
+func connectError(err error) error {
+	switch {
+	case xerrors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case xerrors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+	switch account.KindOf(err) {
+	case account.ErrorKindRateLimited:
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	case account.ErrorKindUnauthorized:
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case account.ErrorKindNotFound:
+		return connect.NewError(connect.CodeNotFound, err)
+	case account.ErrorKindBadRequest:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case account.ErrorKindUpstream:
+		return connect.NewError(connect.CodeUnavailable, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
 	}
-	return nil
 }
 
 func (s *service) SearchSymbols(
 	ctx context.Context, req *connect.Request[v1.SearchSymbolsRequest],
 ) (*connect.Response[v1.SearchSymbolsResponse], error) {
-	if err := s.checkGlobalDataSource(); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	ds, err := s.dataSourceOf(req.Msg.AccountId)
+	if err != nil {
+		return nil, err
 	}
-	symbols, err := s.globalDataSource.Search(ctx, req.Msg.Query)
+	symbols, err := ds.Search(ctx, req.Msg.Query)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 	return &connect.Response[v1.SearchSymbolsResponse]{
 		Msg: &v1.SearchSymbolsResponse{
@@ -58,12 +334,13 @@ func (s *service) SearchSymbols(
 func (s *service) GetOptionExpirations(
 	ctx context.Context, req *connect.Request[v1.GetOptionExpirationsRequest],
 ) (*connect.Response[v1.GetOptionExpirationsResponse], error) {
-	if err := s.checkGlobalDataSource(); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	ds, err := s.dataSourceOf(req.Msg.AccountId)
+	if err != nil {
+		return nil, err
 	}
-	expirations, err := s.globalDataSource.GetOptionExpirations(ctx, req.Msg.Underlying)
+	expirations, err := ds.GetOptionExpirations(ctx, req.Msg.Underlying)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 	return &connect.Response[v1.GetOptionExpirationsResponse]{
 		Msg: &v1.GetOptionExpirationsResponse{
@@ -75,14 +352,40 @@ func (s *service) GetOptionExpirations(
 func (s *service) GetOptionChains(
 	ctx context.Context, req *connect.Request[v1.GetOptionChainsRequest],
 ) (*connect.Response[v1.GetOptionChainsResponse], error) {
-	if err := s.checkGlobalDataSource(); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	ds, err := s.dataSourceOf(req.Msg.AccountId)
+	if err != nil {
+		return nil, err
+	}
+	if mask := req.Msg.OptionMask; len(mask.GetPaths()) > 0 && !mask.IsValid(&v1.Option{}) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument, xerrors.Errorf("invalid option mask: %v", mask.Paths),
+		)
 	}
-	chains, err := s.globalDataSource.GetOptionChains(
+	chains, err := ds.GetOptionChains(
 		ctx, req.Msg.Underlying, req.Msg.Expiration,
 	)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
+	}
+	price := 0.0
+	if needsPrice(req.Msg.Filter) {
+		quotes, err := ds.GetQuotes(ctx, []string{req.Msg.Underlying})
+		if err != nil {
+			return nil, connectError(err)
+		}
+		if len(quotes) == 0 {
+			return nil, connect.NewError(
+				connect.CodeNotFound, xerrors.Errorf("no quote of %s", req.Msg.Underlying),
+			)
+		}
+		price = quotes[0].Last
+		if price == 0 {
+			price = (quotes[0].Bid + quotes[0].Ask) / 2
+		}
+	}
+	chains = filterChains(chains, req.Msg.Filter, price)
+	if err := maskOptions(chains, req.Msg.OptionMask); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 	return &connect.Response[v1.GetOptionChainsResponse]{
 		Msg: &v1.GetOptionChainsResponse{
@@ -90,3 +393,76 @@ func (s *service) GetOptionChains(
 		},
 	}, nil
 }
+
+// maxCalendarDays caps the span of a trade calendar request
+const maxCalendarDays = 366
+
+// checkCalendarRange checks both dates are yyyy-mm-dd and the span is within maxCalendarDays
+func checkCalendarRange(from string, to string) error {
+	fromDate, err := calendar.ParseDate(from)
+	if err != nil {
+		return xerrors.Errorf("invalid from %q: %w", from, err)
+	}
+	toDate, err := calendar.ParseDate(to)
+	if err != nil {
+		return xerrors.Errorf("invalid to %q: %w", to, err)
+	}
+	if fromDate.After(toDate) {
+		return xerrors.Errorf("from %s is after to %s", from, to)
+	}
+	if toDate.Sub(fromDate) > maxCalendarDays*24*time.Hour {
+		return xerrors.Errorf("from %s to %s spans more than %d days", from, to, maxCalendarDays)
+	}
+	return nil
+}
+
+func (s *service) GetTradeCalendar(
+	ctx context.Context, req *connect.Request[v1.GetTradeCalendarRequest],
+) (*connect.Response[v1.GetTradeCalendarResponse], error) {
+	if err := checkCalendarRange(req.Msg.From, req.Msg.To); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	ds, err := s.getGlobal()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	periods, err := ds.GetTradeCalendar(ctx, req.Msg.From, req.Msg.To)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	return &connect.Response[v1.GetTradeCalendarResponse]{
+		Msg: &v1.GetTradeCalendarResponse{
+			Periods: periods,
+		},
+	}, nil
+}
+
+func (s *service) WatchOptionChains(
+	ctx context.Context, req *connect.Request[v1.WatchOptionChainsRequest],
+	stream *connect.ServerStream[v1.WatchOptionChainsResponse],
+) error {
+	ds, err := s.dataSourceOf(req.Msg.AccountId)
+	if err != nil {
+		return err
+	}
+	updates, unsubscribe := s.watcher.Subscribe(
+		ds, req.Msg.AccountId, req.Msg.Underlying, req.Msg.Expiration,
+	)
+	defer unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				return connect.NewError(
+					connect.CodeResourceExhausted,
+					xerrors.New("subscriber is too slow to receive option chain updates"),
+				)
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package datasource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	v1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_AccountID(t *testing.T) {
+	s := &service{accountDataSources: make(map[string]*DataSource)}
+	ctx := context.Background()
+
+	// the market of the requested account answers
+	s.accountDataSources["acc"] = &DataSource{Market: &pollingMarket{}}
+	resp, err := s.GetOptionChains(
+		ctx, connect.NewRequest(&v1.GetOptionChainsRequest{Underlying: "SPX", Expiration: "2024-01-19", AccountId: "acc"}),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "SPX", resp.Msg.Chains[0].Underlying)
+
+	// unknown accounts are invalid arguments
+	_, err = s.GetOptionChains(
+		ctx, connect.NewRequest(&v1.GetOptionChainsRequest{Underlying: "SPX", AccountId: "unknown"}),
+	)
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestService_GetTradeCalendar(t *testing.T) {
+	s := &service{}
+	ctx := context.Background()
+	for _, req := range []*v1.GetTradeCalendarRequest{
+		{From: "2024-01", To: "2024-02-01"},
+		{From: "2024-02-01", To: "2024-01-01"},
+		{From: "2020-01-01", To: "2024-01-01"},
+	} {
+		_, err := s.GetTradeCalendar(ctx, connect.NewRequest(req))
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), req.String())
+	}
+}
+
+func TestService_SharedMarket(t *testing.T) {
+	upstream := &pollingMarket{}
+	s := &service{
+		accountDataSources: make(map[string]*DataSource),
+		markets: map[string]*account.CachedMarket{
+			"acc": account.NewCachedMarket(upstream, account.CacheOptions{ChainTTL: time.Minute}),
+		},
+	}
+	ctx := context.Background()
+
+	// the global and the account data sources share the cache of the account
+	global, err := s.openDataSource([]string{"acc"}, "")
+	assert.NoError(t, err)
+	s.globalDataSource = global
+	own, err := s.getForAccount("acc")
+	assert.NoError(t, err)
+	for _, ds := range []*DataSource{global, own} {
+		_, err = ds.GetOptionChains(ctx, "SPX", "2024-01-19")
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), upstream.polls.Load())
+	health, err := s.GetSourceHealth(ctx, connect.NewRequest(&v1.GetSourceHealthRequest{}))
+	assert.NoError(t, err)
+	stats := health.Msg.Sources[0].CacheStats
+	assert.Equal(t, "GetOptionChains", stats[0].Method)
+	assert.Equal(t, int64(1), stats[0].Hits)
+	assert.Equal(t, int64(1), stats[0].Misses)
+
+	// a deleted account drops its market and the data sources using it
+	s.closeAccount("acc")
+	assert.Empty(t, s.markets)
+	assert.Empty(t, s.accountDataSources)
+	assert.Nil(t, s.globalDataSource)
+}
@@ -0,0 +1,225 @@
+package datasource
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	v1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// subscriberBuffer is how many updates a subscriber may fall behind before it is dropped
+const subscriberBuffer = 16
+
+// watchKey identifies a feed, subscribers of different accounts never share one
+type watchKey struct {
+	accountID  string
+	underlying string
+	expiration string
+}
+
+// watcher fans out option chain updates from a single polling loop per key to all subscribers,
+// so the number of subscribers does not multiply the broker API calls
+type watcher struct {
+	interval time.Duration
+	logger   slog.Logger
+
+	mu    sync.Mutex
+	feeds map[watchKey]*feed
+}
+
+func newWatcher(interval time.Duration, logger slog.Logger) *watcher {
+	return &watcher{
+		interval: interval,
+		logger:   logger,
+		feeds:    make(map[watchKey]*feed),
+	}
+}
+
+type feed struct {
+	key    watchKey
+	market account.Market
+	cancel context.CancelFunc
+
+	// guarded by watcher.mu
+	subscribers map[chan *v1.WatchOptionChainsResponse]struct{}
+	// the latest full chains, sent to new subscribers as the first snapshot
+	latest []*v1.Chain
+}
+
+// Subscribe returns a channel of updates and a func to unsubscribe,
+// the channel is closed if the subscriber can't keep up with the updates
+func (w *watcher) Subscribe(
+	market account.Market, accountID string, underlying string, expiration string,
+) (<-chan *v1.WatchOptionChainsResponse, func()) {
+	key := watchKey{accountID: accountID, underlying: underlying, expiration: expiration}
+	ch := make(chan *v1.WatchOptionChainsResponse, subscriberBuffer)
+
+	w.mu.Lock()
+	f, ok := w.feeds[key]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		f = &feed{
+			key:         key,
+			market:      market,
+			cancel:      cancel,
+			subscribers: make(map[chan *v1.WatchOptionChainsResponse]struct{}),
+		}
+		w.feeds[key] = f
+		go w.poll(ctx, f)
+	}
+	f.subscribers[ch] = struct{}{}
+	if f.latest != nil {
+		ch <- &v1.WatchOptionChainsResponse{IsSnapshot: true, Chains: f.latest}
+	}
+	w.mu.Unlock()
+
+	return ch, func() { w.unsubscribe(f, ch) }
+}
+
+func (w *watcher) unsubscribe(f *feed, ch chan *v1.WatchOptionChainsResponse) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := f.subscribers[ch]; !ok {
+		return // already dropped
+	}
+	delete(f.subscribers, ch)
+	close(ch)
+	w.stopIfIdle(f)
+}
+
+// stopIfIdle stops polling when nobody is watching, must be called with w.mu held
+func (w *watcher) stopIfIdle(f *feed) {
+	if len(f.subscribers) > 0 {
+		return
+	}
+	f.cancel()
+	if w.feeds[f.key] == f {
+		delete(w.feeds, f.key)
+	}
+}
+
+func (w *watcher) poll(ctx context.Context, f *feed) {
+	logger := w.logger.With(
+		slog.F("account_id", f.key.accountID),
+		slog.F("underlying", f.key.underlying), slog.F("expiration", f.key.expiration),
+	)
+	logger.Debug(ctx, "watch started")
+	defer logger.Debug(context.Background(), "watch stopped")
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	// options of the previous poll keyed by symbol
+	var previous map[string]*v1.Option
+	for {
+		chains, err := f.market.GetOptionChains(ctx, f.key.underlying, f.key.expiration)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Warn(ctx, "failed to poll option chains", slog.Error(err))
+		} else {
+			resp := &v1.WatchOptionChainsResponse{IsSnapshot: previous == nil, Chains: chains}
+			current := indexOptions(chains)
+			if previous != nil {
+				resp.Chains = diffChains(previous, chains)
+				resp.RemovedSymbols = removedSymbols(previous, current)
+			}
+			previous = current
+			w.broadcast(f, chains, resp)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *watcher) broadcast(f *feed, chains []*v1.Chain, resp *v1.WatchOptionChainsResponse) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	f.latest = chains
+	if len(resp.Chains) == 0 && len(resp.RemovedSymbols) == 0 {
+		return // nothing changed
+	}
+	for ch := range f.subscribers {
+		select {
+		case ch <- resp:
+		default:
+			// drop the slow subscriber rather than blocking everyone else
+			delete(f.subscribers, ch)
+			close(ch)
+		}
+	}
+	w.stopIfIdle(f)
+}
+
+func indexOptions(chains []*v1.Chain) map[string]*v1.Option {
+	options := make(map[string]*v1.Option)
+	for _, chain := range chains {
+		for _, opt := range chain.Calls {
+			options[opt.Symbol] = opt
+		}
+		for _, opt := range chain.Puts {
+			options[opt.Symbol] = opt
+		}
+	}
+	return options
+}
+
+// diffChains returns chains holding only the options changed since previous, empty chains are omitted
+func diffChains(previous map[string]*v1.Option, chains []*v1.Chain) []*v1.Chain {
+	changed := func(opts []*v1.Option) []*v1.Option {
+		var rets []*v1.Option
+		for _, opt := range opts {
+			if !sameQuote(previous[opt.Symbol], opt) {
+				rets = append(rets, opt)
+			}
+		}
+		return rets
+	}
+	var rets []*v1.Chain
+	for _, chain := range chains {
+		diff := &v1.Chain{
+			RootSymbol: chain.RootSymbol,
+			Underlying: chain.Underlying,
+			Expiration: chain.Expiration,
+			Calls:      changed(chain.Calls),
+			Puts:       changed(chain.Puts),
+		}
+		if len(diff.Calls) > 0 || len(diff.Puts) > 0 {
+			rets = append(rets, diff)
+		}
+	}
+	return rets
+}
+
+// removedSymbols returns the sorted symbols of previous which are gone from current
+func removedSymbols(previous, current map[string]*v1.Option) []string {
+	var removed []string
+	for symbol := range previous {
+		if _, ok := current[symbol]; !ok {
+			removed = append(removed, symbol)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// sameQuote compares two options ignoring QuoteAt, which is the time of polling rather than of the quote
+func sameQuote(a, b *v1.Option) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.QuoteAt == b.QuoteAt {
+		return proto.Equal(a, b)
+	}
+	b = proto.Clone(b).(*v1.Option)
+	b.QuoteAt = a.QuoteAt
+	return proto.Equal(a, b)
+}
@@ -0,0 +1,75 @@
+package datasource
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	v1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// pollingMarket returns a chain whose first call's bid increases on every poll,
+// the second call is gone from the third poll
+type pollingMarket struct {
+	account.Market
+	polls atomic.Int32
+}
+
+func (m *pollingMarket) GetOptionChains(
+	ctx context.Context, underlying string, expiration string,
+) ([]*v1.Chain, error) {
+	n := m.polls.Add(1)
+	calls := []*v1.Option{{Symbol: "C1", Strike: 100, Bid: float64(n), QuoteAt: time.Now().UnixMilli()}}
+	if n < 3 {
+		calls = append(calls, &v1.Option{Symbol: "C2", Strike: 110, Bid: 1, QuoteAt: time.Now().UnixMilli()})
+	}
+	return []*v1.Chain{
+		{
+			RootSymbol: underlying,
+			Underlying: underlying,
+			Expiration: expiration,
+			Calls:      calls,
+		},
+	}, nil
+}
+
+func TestWatcher_Subscribe(t *testing.T) {
+	market := &pollingMarket{}
+	w := newWatcher(10*time.Millisecond, util.DefaultLogger)
+
+	first, unsubscribeFirst := w.Subscribe(market, "", "SPX", "2024-01-19")
+	second, unsubscribeSecond := w.Subscribe(market, "", "SPX", "2024-01-19")
+
+	snapshot := <-first
+	assert.True(t, snapshot.IsSnapshot)
+	assert.Len(t, snapshot.Chains[0].Calls, 2)
+	// only the changed option is sent after the snapshot
+	update := <-first
+	assert.False(t, update.IsSnapshot)
+	assert.Len(t, update.Chains[0].Calls, 1)
+	assert.Equal(t, "C1", update.Chains[0].Calls[0].Symbol)
+	// the removed option is signalled
+	update = <-first
+	assert.Equal(t, []string{"C2"}, update.RemovedSymbols)
+
+	// both subscribers share one polling loop, but not with another account
+	assert.True(t, (<-second).IsSnapshot)
+	other := &pollingMarket{}
+	third, unsubscribeThird := w.Subscribe(other, "acc", "SPX", "2024-01-19")
+	assert.True(t, (<-third).IsSnapshot)
+	w.mu.Lock()
+	assert.Len(t, w.feeds, 2)
+	w.mu.Unlock()
+	assert.Positive(t, other.polls.Load())
+
+	unsubscribeFirst()
+	unsubscribeSecond()
+	unsubscribeThird()
+	w.mu.Lock()
+	assert.Empty(t, w.feeds)
+	w.mu.Unlock()
+}
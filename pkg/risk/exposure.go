@@ -0,0 +1,168 @@
+package risk
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	"github.com/ppaanngggg/option-bot/pkg/bot"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	riskv1 "github.com/ppaanngggg/option-bot/proto/gen/risk/v1"
+	"golang.org/x/xerrors"
+)
+
+// Aggregate combines the open positions with fresh chain greeks and quotes of the market into
+// exposures per underlying, per account and overall, legs missing from the chains are returned
+// as missing symbols rather than failing the whole snapshot
+func Aggregate(
+	ctx context.Context, market account.Market, positions []*botv1.Position, now time.Time,
+) (*riskv1.ExposureSnapshot, []string, error) {
+	benchmark := util.Conf.Risk.Benchmark
+	snapshot := &riskv1.ExposureSnapshot{
+		CreatedAt: now.UnixMilli(),
+		Benchmark: benchmark,
+		Total:     &riskv1.Greeks{},
+	}
+	if len(positions) == 0 {
+		return snapshot, nil, nil
+	}
+
+	// fetch each chain once, keyed by underlying and expiration
+	type chainKey struct {
+		underlying string
+		expiration string
+	}
+	options := make(map[string]*datasourcev1.Option)
+	fetched := make(map[chainKey]bool)
+	underlyings := map[string]bool{benchmark: true}
+	for _, position := range positions {
+		underlyings[position.Underlying] = true
+		for _, leg := range position.Legs {
+			key := chainKey{underlying: position.Underlying, expiration: leg.Expiration}
+			if fetched[key] {
+				continue
+			}
+			chains, err := market.GetOptionChains(ctx, key.underlying, key.expiration)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, chain := range chains {
+				for _, opt := range chain.Calls {
+					options[opt.Symbol] = opt
+				}
+				for _, opt := range chain.Puts {
+					options[opt.Symbol] = opt
+				}
+			}
+			fetched[key] = true
+		}
+	}
+	prices, err := getPrices(ctx, market, underlyings)
+	if err != nil {
+		return nil, nil, err
+	}
+	snapshot.BenchmarkPrice = prices[benchmark]
+	if snapshot.BenchmarkPrice <= 0 {
+		return nil, nil, xerrors.Errorf("no price of the benchmark %s", benchmark)
+	}
+
+	var missing []string
+	accounts := make(map[string]*riskv1.AccountExposure)
+	byUnderlying := make(map[string]map[string]*riskv1.UnderlyingExposure)
+	for _, position := range positions {
+		acc, ok := accounts[position.AccountId]
+		if !ok {
+			acc = &riskv1.AccountExposure{AccountId: position.AccountId, Greeks: &riskv1.Greeks{}}
+			accounts[position.AccountId] = acc
+			byUnderlying[position.AccountId] = make(map[string]*riskv1.UnderlyingExposure)
+		}
+		exp, ok := byUnderlying[position.AccountId][position.Underlying]
+		if !ok {
+			exp = &riskv1.UnderlyingExposure{
+				Underlying:      position.Underlying,
+				UnderlyingPrice: prices[position.Underlying],
+				Beta:            Beta(position.Underlying),
+				Greeks:          &riskv1.Greeks{},
+			}
+			byUnderlying[position.AccountId][position.Underlying] = exp
+			acc.Underlyings = append(acc.Underlyings, exp)
+		}
+		exp.Positions++
+		for _, leg := range position.Legs {
+			opt, ok := options[leg.Symbol]
+			if !ok {
+				missing = append(missing, leg.Symbol)
+				continue
+			}
+			shares := float64(leg.Quantity) * bot.ContractMultiplier
+			greeks := &riskv1.Greeks{
+				Delta: opt.Delta * shares,
+				Gamma: opt.Gamma * shares,
+				Theta: opt.Theta * shares,
+				Vega:  opt.Vega * shares,
+			}
+			greeks.BetaWeightedDelta = greeks.Delta * exp.Beta *
+				exp.UnderlyingPrice / snapshot.BenchmarkPrice
+			addGreeks(exp.Greeks, greeks)
+			addGreeks(acc.Greeks, greeks)
+			addGreeks(snapshot.Total, greeks)
+		}
+	}
+	for _, acc := range accounts {
+		sort.Slice(
+			acc.Underlyings, func(i, j int) bool {
+				return acc.Underlyings[i].Underlying < acc.Underlyings[j].Underlying
+			},
+		)
+		snapshot.Accounts = append(snapshot.Accounts, acc)
+	}
+	sort.Slice(
+		snapshot.Accounts, func(i, j int) bool {
+			return snapshot.Accounts[i].AccountId < snapshot.Accounts[j].AccountId
+		},
+	)
+	return snapshot, missing, nil
+}
+
+// Beta returns the configured beta of the underlying to the benchmark, 1 if absent
+func Beta(underlying string) float64 {
+	if beta, ok := util.Conf.Risk.Betas[underlying]; ok {
+		return beta
+	}
+	return 1
+}
+
+// getPrices returns the last price of each symbol, or the mid price if it never traded today
+func getPrices(
+	ctx context.Context, market account.Market, symbols map[string]bool,
+) (map[string]float64, error) {
+	list := make([]string, 0, len(symbols))
+	for symbol := range symbols {
+		list = append(list, symbol)
+	}
+	sort.Strings(list)
+	quotes, err := market.GetQuotes(ctx, list)
+	if err != nil {
+		return nil, err
+	}
+	prices := make(map[string]float64, len(quotes))
+	for _, quote := range quotes {
+		price := quote.Last
+		if price <= 0 && quote.Bid > 0 && quote.Ask > 0 {
+			price = (quote.Bid + quote.Ask) / 2
+		}
+		prices[quote.Symbol] = price
+	}
+	return prices, nil
+}
+
+func addGreeks(sum *riskv1.Greeks, g *riskv1.Greeks) {
+	sum.Delta += g.Delta
+	sum.Gamma += g.Gamma
+	sum.Theta += g.Theta
+	sum.Vega += g.Vega
+	sum.BetaWeightedDelta += g.BetaWeightedDelta
+}
@@ -0,0 +1,75 @@
+package risk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ppaanngggg/option-bot/pkg/account"
+	botv1 "github.com/ppaanngggg/option-bot/proto/gen/bot/v1"
+	datasourcev1 "github.com/ppaanngggg/option-bot/proto/gen/datasource/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeMarket struct {
+	account.Market
+}
+
+func (m *fakeMarket) GetOptionChains(
+	ctx context.Context, underlying string, expiration string,
+) ([]*datasourcev1.Chain, error) {
+	return []*datasourcev1.Chain{
+		{
+			RootSymbol: "SPY",
+			Underlying: "SPY",
+			Expiration: expiration,
+			Puts: []*datasourcev1.Option{
+				{Symbol: "SPY240119P00460000", Delta: -0.2, Gamma: 0.01, Theta: -0.1, Vega: 0.3},
+				{Symbol: "SPY240119P00450000", Delta: -0.1, Gamma: 0.005, Theta: -0.05, Vega: 0.2},
+			},
+		},
+	}, nil
+}
+
+func (m *fakeMarket) GetQuotes(ctx context.Context, symbols []string) (
+	[]*datasourcev1.Quote, error,
+) {
+	return []*datasourcev1.Quote{
+		{Symbol: "SPX", Last: 4800},
+		{Symbol: "SPY", Bid: 479, Ask: 481},
+	}, nil
+}
+
+func TestAggregate(t *testing.T) {
+	// a put credit spread of 2 units
+	positions := []*botv1.Position{
+		{
+			Id:         "p1",
+			AccountId:  "a1",
+			Underlying: "SPY",
+			Status:     botv1.PositionStatus_POSITION_STATUS_OPEN,
+			Legs: []*botv1.PositionLeg{
+				{Symbol: "SPY240119P00460000", Expiration: "2024-01-19", Quantity: -2},
+				{Symbol: "SPY240119P00450000", Expiration: "2024-01-19", Quantity: 2},
+				{Symbol: "SPY240119P00440000", Expiration: "2024-01-19", Quantity: 2},
+			},
+		},
+	}
+	snapshot, missing, err := Aggregate(context.Background(), &fakeMarket{}, positions, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SPY240119P00440000"}, missing)
+	assert.Equal(t, 4800.0, snapshot.BenchmarkPrice)
+
+	// short put delta: -2 * 100 * -0.2 + 2 * 100 * -0.1 = 20
+	assert.InDelta(t, 20, snapshot.Total.Delta, 1e-9)
+	assert.InDelta(t, 10, snapshot.Total.Theta, 1e-9)
+	// SPY's beta is 1, 20 deltas of SPY at 480 are 2 deltas of SPX at 4800
+	assert.InDelta(t, 2, snapshot.Total.BetaWeightedDelta, 1e-9)
+
+	assert.Len(t, snapshot.Accounts, 1)
+	assert.Equal(t, "a1", snapshot.Accounts[0].AccountId)
+	exposure := snapshot.Accounts[0].Underlyings[0]
+	assert.Equal(t, 480.0, exposure.UnderlyingPrice)
+	assert.Equal(t, int32(1), exposure.Positions)
+	assert.InDelta(t, -1, exposure.Greeks.Gamma, 1e-9)
+}
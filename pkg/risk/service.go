@@ -0,0 +1,108 @@
+package risk
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"cdr.dev/slog"
+	"connectrpc.com/connect"
+	"github.com/ppaanngggg/option-bot/pkg/bot"
+	"github.com/ppaanngggg/option-bot/pkg/datasource"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	riskv1 "github.com/ppaanngggg/option-bot/proto/gen/risk/v1"
+	"github.com/ppaanngggg/option-bot/proto/gen/risk/v1/riskv1connect"
+)
+
+var Service riskv1connect.RiskServiceHandler
+
+func init() {
+	Service = &service{
+		logger: util.DefaultLogger.With(slog.F("risk", "service")),
+	}
+}
+
+// maxSnapshotPageSize caps the snapshots of one list
+const maxSnapshotPageSize = 1000
+
+type service struct {
+	logger slog.Logger
+}
+
+func (s *service) GetExposure(
+	ctx context.Context, req *connect.Request[riskv1.GetExposureRequest],
+) (*connect.Response[riskv1.GetExposureResponse], error) {
+	snapshot, err := takeSnapshot(ctx, s.logger)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return &connect.Response[riskv1.GetExposureResponse]{
+		Msg: &riskv1.GetExposureResponse{
+			Exposure: snapshot,
+		},
+	}, nil
+}
+
+func (s *service) ListExposureSnapshots(
+	ctx context.Context, req *connect.Request[riskv1.ListExposureSnapshotsRequest],
+) (*connect.Response[riskv1.ListExposureSnapshotsResponse], error) {
+	to := req.Msg.To
+	if to == 0 {
+		to = time.Now().UnixMilli()
+	}
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 || pageSize > maxSnapshotPageSize {
+		pageSize = maxSnapshotPageSize
+	}
+	// snapshots are appended in the order of time, so read from the latest and stop before from
+	var (
+		snapshots []*riskv1.ExposureSnapshot
+		nextTo    int64
+	)
+	err := Snapshotter.history.ReadBackward(
+		func(snapshot *riskv1.ExposureSnapshot) bool {
+			if snapshot.CreatedAt > to {
+				return true
+			}
+			if snapshot.CreatedAt < req.Msg.From {
+				return false
+			}
+			if len(snapshots) == pageSize {
+				nextTo = snapshot.CreatedAt
+				return false
+			}
+			snapshots = append(snapshots, snapshot)
+			return true
+		},
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	slices.Reverse(snapshots)
+	return &connect.Response[riskv1.ListExposureSnapshotsResponse]{
+		Msg: &riskv1.ListExposureSnapshotsResponse{
+			Snapshots: snapshots,
+			NextTo:    nextTo,
+		},
+	}, nil
+}
+
+// takeSnapshot aggregates all open positions with the global data source
+func takeSnapshot(ctx context.Context, logger slog.Logger) (*riskv1.ExposureSnapshot, error) {
+	ds, err := datasource.Global()
+	if err != nil {
+		return nil, err
+	}
+	positions, err := bot.Positions.ListOpen(nil)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, missing, err := Aggregate(ctx, ds, positions, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		logger.Warn(ctx, "legs missing from option chains", slog.F("symbols", missing))
+	}
+	return snapshot, nil
+}
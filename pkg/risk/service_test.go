@@ -0,0 +1,45 @@
+package risk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	riskv1 "github.com/ppaanngggg/option-bot/proto/gen/risk/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_ListExposureSnapshots(t *testing.T) {
+	util.Conf.Storage.Dir = t.TempDir()
+	Snapshotter.history = util.NewAppendLog[*riskv1.ExposureSnapshot]("exposure_snapshots")
+	// long enough lines to span several chunks of the backward read
+	for i := int64(1); i <= 100; i++ {
+		assert.NoError(
+			t, Snapshotter.history.Append(
+				&riskv1.ExposureSnapshot{CreatedAt: i * 1000, Benchmark: strings.Repeat("X", 1000)},
+			),
+		)
+	}
+	s := &service{}
+	ctx := context.Background()
+	resp, err := s.ListExposureSnapshots(
+		ctx, connect.NewRequest(&riskv1.ListExposureSnapshotsRequest{From: 10000, To: 50000, PageSize: 30}),
+	)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.Snapshots, 30)
+	assert.Equal(t, int64(21000), resp.Msg.Snapshots[0].CreatedAt)
+	assert.Equal(t, int64(50000), resp.Msg.Snapshots[29].CreatedAt)
+	assert.Equal(t, int64(20000), resp.Msg.NextTo)
+
+	resp, err = s.ListExposureSnapshots(
+		ctx, connect.NewRequest(
+			&riskv1.ListExposureSnapshotsRequest{From: 10000, To: resp.Msg.NextTo, PageSize: 30},
+		),
+	)
+	assert.NoError(t, err)
+	assert.Len(t, resp.Msg.Snapshots, 11)
+	assert.Equal(t, int64(10000), resp.Msg.Snapshots[0].CreatedAt)
+	assert.Zero(t, resp.Msg.NextTo)
+}
@@ -0,0 +1,52 @@
+package risk
+
+import (
+	"context"
+	"time"
+
+	"cdr.dev/slog"
+	"github.com/ppaanngggg/option-bot/pkg/bot"
+	"github.com/ppaanngggg/option-bot/pkg/util"
+	riskv1 "github.com/ppaanngggg/option-bot/proto/gen/risk/v1"
+)
+
+var Snapshotter = &snapshotter{
+	history: util.NewAppendLog[*riskv1.ExposureSnapshot]("exposure_snapshots"),
+	logger:  util.DefaultLogger.With(slog.F("risk", "snapshotter")),
+}
+
+// snapshotter records the exposure periodically for charting
+type snapshotter struct {
+	history *util.AppendLog[*riskv1.ExposureSnapshot]
+	logger  slog.Logger
+}
+
+func (s *snapshotter) Run(ctx context.Context) {
+	s.logger.Info(ctx, "snapshotter started")
+	ticker := time.NewTicker(util.Conf.Risk.SnapshotInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		// nothing to chart without positions
+		positions, err := bot.Positions.ListOpen(nil)
+		if err != nil {
+			s.logger.Error(ctx, "failed to list open positions", slog.Error(err))
+			continue
+		}
+		if len(positions) == 0 {
+			continue
+		}
+		snapshot, err := takeSnapshot(ctx, s.logger)
+		if err != nil {
+			s.logger.Warn(ctx, "failed to take exposure snapshot", slog.Error(err))
+			continue
+		}
+		if err := s.history.Append(snapshot); err != nil {
+			s.logger.Error(ctx, "failed to save exposure snapshot", slog.Error(err))
+		}
+	}
+}
@@ -2,6 +2,7 @@ package util
 
 import (
 	"os"
+	"time"
 
 	"cdr.dev/slog"
 	"cdr.dev/slog/sloggers/sloghuman"
@@ -18,6 +19,67 @@ var Conf = &struct {
 		Level  string `env:"LOG_LEVEL" envDefault:"info"`
 		Format string `env:"LOG_FORMAT" envDefault:"human"`
 	}
+	Market struct {
+		// cache TTL of the broker responses
+		SearchTTL     time.Duration `env:"MARKET_SEARCH_TTL" envDefault:"1h"`
+		ExpirationTTL time.Duration `env:"MARKET_EXPIRATION_TTL" envDefault:"1h"`
+		ChainTTL      time.Duration `env:"MARKET_CHAIN_TTL" envDefault:"3s"`
+		CalendarTTL   time.Duration `env:"MARKET_CALENDAR_TTL" envDefault:"6h"`
+		// request limit per account, Tradier allows 120 market data requests per minute
+		RatePerMinute int `env:"MARKET_RATE_PER_MINUTE" envDefault:"120"`
+		Burst         int `env:"MARKET_BURST" envDefault:"10"`
+		// timeout of one upstream call shared by the coalesced callers
+		FetchTimeout time.Duration `env:"MARKET_FETCH_TIMEOUT" envDefault:"30s"`
+		// stream the quotes of the fetched option chains when the broker supports it, e.g. live Tradier accounts
+		Stream bool `env:"MARKET_STREAM" envDefault:"true"`
+	}
+	DataSource struct {
+		// interval between two polls of a watched option chain
+		WatchInterval time.Duration `env:"DATASOURCE_WATCH_INTERVAL" envDefault:"5s"`
+		// answers older than these fail over to the next source, 0 disables the check
+		MaxQuoteAge  time.Duration `env:"DATASOURCE_MAX_QUOTE_AGE" envDefault:"1m"`
+		MaxGreeksAge time.Duration `env:"DATASOURCE_MAX_GREEKS_AGE" envDefault:"5m"`
+		// a failed source is tried after the healthy ones for this long
+		FailoverCooldown time.Duration `env:"DATASOURCE_FAILOVER_COOLDOWN" envDefault:"30s"`
+	}
+	Storage struct {
+		Dir string `env:"STORAGE_DIR" envDefault:"./data"`
+	}
+	Risk struct {
+		// interval between two exposure snapshots
+		SnapshotInterval time.Duration `env:"RISK_SNAPSHOT_INTERVAL" envDefault:"5m"`
+		// beta weighted delta is normalized to the benchmark
+		Benchmark string `env:"RISK_BENCHMARK" envDefault:"SPX"`
+		// beta of each underlying to the benchmark, 1 if absent, e.g. "QQQ:1.2,IWM:1.1"
+		Betas map[string]float64 `env:"RISK_BETAS" envDefault:"SPX:1,SPY:1,XSP:1"`
+	}
+	Bot struct {
+		// interval between two checks of the scheduler
+		SchedulerInterval time.Duration `env:"BOT_SCHEDULER_INTERVAL" envDefault:"30s"`
+		// an unfilled order is canceled after the timeout
+		FillTimeout      time.Duration `env:"BOT_FILL_TIMEOUT" envDefault:"1m"`
+		FillPollInterval time.Duration `env:"BOT_FILL_POLL_INTERVAL" envDefault:"2s"`
+		// limit prices are rounded to the tick
+		PriceTick float64 `env:"BOT_PRICE_TICK" envDefault:"0.05"`
+		// IV rank and percentile compare with the daily at-the-money IV samples of the lookback,
+		// measured at the expiration nearest to the target DTE
+		IVLookbackDays int `env:"BOT_IV_LOOKBACK_DAYS" envDefault:"365"`
+		IVMinSamples   int `env:"BOT_IV_MIN_SAMPLES" envDefault:"20"`
+		IVTargetDTE    int `env:"BOT_IV_TARGET_DTE" envDefault:"30"`
+		// the IV of the underlyings with IV conditions is sampled daily in the time before the close,
+		// regardless of the entries of their bots
+		IVSampleBeforeClose time.Duration `env:"BOT_IV_SAMPLE_BEFORE_CLOSE" envDefault:"15m"`
+		// the symbol of the VIX index of the market data
+		VIXSymbol string `env:"BOT_VIX_SYMBOL" envDefault:"VIX"`
+		// expiring positions are closed by the expiry policy in the time before the last trade time
+		ExpiryCloseBefore time.Duration `env:"BOT_EXPIRY_CLOSE_BEFORE" envDefault:"15m"`
+		// defaults of the adjustments, so that a position still tested after a roll doesn't roll every check
+		MaxRolls     int32         `env:"BOT_MAX_ROLLS" envDefault:"3"`
+		RollCooldown time.Duration `env:"BOT_ROLL_COOLDOWN" envDefault:"30m"`
+	}
+	Archive struct {
+		Dir string `env:"ARCHIVE_DIR" envDefault:"./archive"`
+	}
 }{}
 
 var DefaultLogger slog.Logger
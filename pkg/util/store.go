@@ -0,0 +1,231 @@
+package util
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/xerrors"
+)
+
+// FileStore keeps records in memory keyed by id and persists all of them as one json file
+// in Conf.Storage.Dir on every write, the file is loaded on first use.
+// Records are shared with callers, so modify a copy and Put it back.
+type FileStore[T any] struct {
+	path string
+
+	once    sync.Once
+	loadErr error
+	mu      sync.RWMutex
+	records map[string]T
+}
+
+func NewFileStore[T any](name string) *FileStore[T] {
+	return &FileStore[T]{
+		path:    filepath.Join(Conf.Storage.Dir, name+".json"),
+		records: make(map[string]T),
+	}
+}
+
+func (s *FileStore[T]) load() error {
+	s.once.Do(
+		func() {
+			data, err := os.ReadFile(s.path)
+			if errors.Is(err, os.ErrNotExist) {
+				return
+			}
+			if err != nil {
+				s.loadErr = xerrors.New(err.Error())
+				return
+			}
+			if err := json.Unmarshal(data, &s.records); err != nil {
+				s.loadErr = xerrors.Errorf("failed to load %s: %w", s.path, err)
+			}
+		},
+	)
+	return s.loadErr
+}
+
+func (s *FileStore[T]) Get(id string) (T, bool, error) {
+	var zero T
+	if err := s.load(); err != nil {
+		return zero, false, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	record, ok := s.records[id]
+	return record, ok, nil
+}
+
+// List returns the records matching the filter, a nil filter matches all
+func (s *FileStore[T]) List(filter func(T) bool) ([]T, error) {
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var records []T
+	for _, record := range s.records {
+		if filter == nil || filter(record) {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func (s *FileStore[T]) Put(id string, record T) error {
+	if err := s.load(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records[id] = record
+	return s.saveLocked()
+}
+
+func (s *FileStore[T]) Delete(id string) error {
+	if err := s.load(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, id)
+	return s.saveLocked()
+}
+
+// saveLocked writes to a temp file and renames it, so that a crash never leaves a partial file
+func (s *FileStore[T]) saveLocked() error {
+	data, err := json.Marshal(s.records)
+	if err != nil {
+		return xerrors.New(err.Error())
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return xerrors.New(err.Error())
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return xerrors.New(err.Error())
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return xerrors.New(err.Error())
+	}
+	return nil
+}
+
+// AppendLog appends records as json lines to a file in Conf.Storage.Dir, records are never modified
+type AppendLog[T any] struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewAppendLog[T any](name string) *AppendLog[T] {
+	return &AppendLog[T]{path: filepath.Join(Conf.Storage.Dir, name+".jsonl")}
+}
+
+func (l *AppendLog[T]) Append(record T) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return xerrors.New(err.Error())
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+		return xerrors.New(err.Error())
+	}
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return xerrors.New(err.Error())
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return xerrors.New(err.Error())
+	}
+	return nil
+}
+
+// readBackwardChunk is how many bytes ReadBackward reads at once
+const readBackwardChunk = 64 * 1024
+
+// ReadBackward visits the records from the last appended one, until visit returns false,
+// so that the latest records are read without reading the whole file
+func (l *AppendLog[T]) ReadBackward(visit func(T) bool) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f, err := os.Open(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return xerrors.New(err.Error())
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return xerrors.New(err.Error())
+	}
+	// rest holds the head of the last chunk read, which may be a partial line
+	offset := info.Size()
+	var rest []byte
+	for offset > 0 {
+		size := min(offset, readBackwardChunk)
+		offset -= size
+		chunk := make([]byte, size, size+int64(len(rest)))
+		if _, err := f.ReadAt(chunk, offset); err != nil {
+			return xerrors.New(err.Error())
+		}
+		rest = append(chunk, rest...)
+		lines := bytes.Split(rest, []byte{'\n'})
+		// the first line may continue in the previous chunk
+		rest = lines[0]
+		if offset == 0 {
+			lines = append([][]byte{nil}, lines...)
+		}
+		for i := len(lines) - 1; i > 0; i-- {
+			if len(lines[i]) == 0 {
+				continue
+			}
+			var record T
+			if err := json.Unmarshal(lines[i], &record); err != nil {
+				return xerrors.Errorf("failed to read %s: %w", l.path, err)
+			}
+			if !visit(record) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// Read returns the records matching the filter in the order of appending, a nil filter matches all
+func (l *AppendLog[T]) Read(filter func(T) bool) ([]T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f, err := os.Open(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	defer f.Close()
+	var records []T
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var record T
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, xerrors.Errorf("failed to read %s: %w", l.path, err)
+		}
+		if filter == nil || filter(record) {
+			records = append(records, record)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.New(err.Error())
+	}
+	return records, nil
+}
@@ -279,6 +279,369 @@ func (Allocator) EnumDescriptor() ([]byte, []int) {
 	return file_bot_v1_bot_proto_rawDescGZIP(), []int{4}
 }
 
+type TargetUnit int32
+
+const (
+	// percent of the entry value, i.e. the credit received or the debit paid
+	TargetUnit_TARGET_UNIT_UNSPECIFIED TargetUnit = 0
+	// dollars of the whole position
+	TargetUnit_TARGET_UNIT_DOLLAR TargetUnit = 1
+)
+
+// Enum value maps for TargetUnit.
+var (
+	TargetUnit_name = map[int32]string{
+		0: "TARGET_UNIT_UNSPECIFIED",
+		1: "TARGET_UNIT_DOLLAR",
+	}
+	TargetUnit_value = map[string]int32{
+		"TARGET_UNIT_UNSPECIFIED": 0,
+		"TARGET_UNIT_DOLLAR":      1,
+	}
+)
+
+func (x TargetUnit) Enum() *TargetUnit {
+	p := new(TargetUnit)
+	*p = x
+	return p
+}
+
+func (x TargetUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TargetUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_bot_v1_bot_proto_enumTypes[5].Descriptor()
+}
+
+func (TargetUnit) Type() protoreflect.EnumType {
+	return &file_bot_v1_bot_proto_enumTypes[5]
+}
+
+func (x TargetUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TargetUnit.Descriptor instead.
+func (TargetUnit) EnumDescriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{5}
+}
+
+type ExpiryPolicy int32
+
+const (
+	// hold the expiring legs to the settlement, their settlement values are recorded after it
+	ExpiryPolicy_EXPIRY_POLICY_UNSPECIFIED ExpiryPolicy = 0
+	// close a position before the last trade time if a short leg is in the money or pinned
+	ExpiryPolicy_EXPIRY_POLICY_CLOSE_ITM ExpiryPolicy = 1
+	// close every expiring position before the last trade time
+	ExpiryPolicy_EXPIRY_POLICY_CLOSE_ALL ExpiryPolicy = 2
+)
+
+// Enum value maps for ExpiryPolicy.
+var (
+	ExpiryPolicy_name = map[int32]string{
+		0: "EXPIRY_POLICY_UNSPECIFIED",
+		1: "EXPIRY_POLICY_CLOSE_ITM",
+		2: "EXPIRY_POLICY_CLOSE_ALL",
+	}
+	ExpiryPolicy_value = map[string]int32{
+		"EXPIRY_POLICY_UNSPECIFIED": 0,
+		"EXPIRY_POLICY_CLOSE_ITM":   1,
+		"EXPIRY_POLICY_CLOSE_ALL":   2,
+	}
+)
+
+func (x ExpiryPolicy) Enum() *ExpiryPolicy {
+	p := new(ExpiryPolicy)
+	*p = x
+	return p
+}
+
+func (x ExpiryPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExpiryPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_bot_v1_bot_proto_enumTypes[6].Descriptor()
+}
+
+func (ExpiryPolicy) Type() protoreflect.EnumType {
+	return &file_bot_v1_bot_proto_enumTypes[6]
+}
+
+func (x ExpiryPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExpiryPolicy.Descriptor instead.
+func (ExpiryPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{6}
+}
+
+type PositionStatus int32
+
+const (
+	PositionStatus_POSITION_STATUS_UNSPECIFIED PositionStatus = 0
+	PositionStatus_POSITION_STATUS_OPEN        PositionStatus = 1
+	PositionStatus_POSITION_STATUS_CLOSED      PositionStatus = 2
+)
+
+// Enum value maps for PositionStatus.
+var (
+	PositionStatus_name = map[int32]string{
+		0: "POSITION_STATUS_UNSPECIFIED",
+		1: "POSITION_STATUS_OPEN",
+		2: "POSITION_STATUS_CLOSED",
+	}
+	PositionStatus_value = map[string]int32{
+		"POSITION_STATUS_UNSPECIFIED": 0,
+		"POSITION_STATUS_OPEN":        1,
+		"POSITION_STATUS_CLOSED":      2,
+	}
+)
+
+func (x PositionStatus) Enum() *PositionStatus {
+	p := new(PositionStatus)
+	*p = x
+	return p
+}
+
+func (x PositionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PositionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bot_v1_bot_proto_enumTypes[7].Descriptor()
+}
+
+func (PositionStatus) Type() protoreflect.EnumType {
+	return &file_bot_v1_bot_proto_enumTypes[7]
+}
+
+func (x PositionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PositionStatus.Descriptor instead.
+func (PositionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{7}
+}
+
+type RunOutcome int32
+
+const (
+	RunOutcome_RUN_OUTCOME_UNSPECIFIED RunOutcome = 0
+	// the entry check didn't pass
+	RunOutcome_RUN_OUTCOME_SKIPPED RunOutcome = 1
+	// failed to resolve, place or fill the order
+	RunOutcome_RUN_OUTCOME_FAILED RunOutcome = 2
+	// blocked by the risk checks
+	RunOutcome_RUN_OUTCOME_VETOED RunOutcome = 3
+	RunOutcome_RUN_OUTCOME_OPENED RunOutcome = 4
+)
+
+// Enum value maps for RunOutcome.
+var (
+	RunOutcome_name = map[int32]string{
+		0: "RUN_OUTCOME_UNSPECIFIED",
+		1: "RUN_OUTCOME_SKIPPED",
+		2: "RUN_OUTCOME_FAILED",
+		3: "RUN_OUTCOME_VETOED",
+		4: "RUN_OUTCOME_OPENED",
+	}
+	RunOutcome_value = map[string]int32{
+		"RUN_OUTCOME_UNSPECIFIED": 0,
+		"RUN_OUTCOME_SKIPPED":     1,
+		"RUN_OUTCOME_FAILED":      2,
+		"RUN_OUTCOME_VETOED":      3,
+		"RUN_OUTCOME_OPENED":      4,
+	}
+)
+
+func (x RunOutcome) Enum() *RunOutcome {
+	p := new(RunOutcome)
+	*p = x
+	return p
+}
+
+func (x RunOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RunOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_bot_v1_bot_proto_enumTypes[8].Descriptor()
+}
+
+func (RunOutcome) Type() protoreflect.EnumType {
+	return &file_bot_v1_bot_proto_enumTypes[8]
+}
+
+func (x RunOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RunOutcome.Descriptor instead.
+func (RunOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{8}
+}
+
+type ExecutionStyle int32
+
+const (
+	// limit order at mid
+	ExecutionStyle_EXECUTION_STYLE_UNSPECIFIED ExecutionStyle = 0
+	ExecutionStyle_EXECUTION_STYLE_MARKET      ExecutionStyle = 1
+	ExecutionStyle_EXECUTION_STYLE_LIMIT       ExecutionStyle = 2
+)
+
+// Enum value maps for ExecutionStyle.
+var (
+	ExecutionStyle_name = map[int32]string{
+		0: "EXECUTION_STYLE_UNSPECIFIED",
+		1: "EXECUTION_STYLE_MARKET",
+		2: "EXECUTION_STYLE_LIMIT",
+	}
+	ExecutionStyle_value = map[string]int32{
+		"EXECUTION_STYLE_UNSPECIFIED": 0,
+		"EXECUTION_STYLE_MARKET":      1,
+		"EXECUTION_STYLE_LIMIT":       2,
+	}
+)
+
+func (x ExecutionStyle) Enum() *ExecutionStyle {
+	p := new(ExecutionStyle)
+	*p = x
+	return p
+}
+
+func (x ExecutionStyle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionStyle) Descriptor() protoreflect.EnumDescriptor {
+	return file_bot_v1_bot_proto_enumTypes[9].Descriptor()
+}
+
+func (ExecutionStyle) Type() protoreflect.EnumType {
+	return &file_bot_v1_bot_proto_enumTypes[9]
+}
+
+func (x ExecutionStyle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionStyle.Descriptor instead.
+func (ExecutionStyle) EnumDescriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{9}
+}
+
+type TradingAction int32
+
+const (
+	TradingAction_TRADING_ACTION_UNSPECIFIED TradingAction = 0
+	// stop opening positions of all bots
+	TradingAction_TRADING_ACTION_HALT   TradingAction = 1
+	TradingAction_TRADING_ACTION_RESUME TradingAction = 2
+	// the result of the flatten of a halt, only recorded by the audit events
+	TradingAction_TRADING_ACTION_FLATTEN TradingAction = 3
+)
+
+// Enum value maps for TradingAction.
+var (
+	TradingAction_name = map[int32]string{
+		0: "TRADING_ACTION_UNSPECIFIED",
+		1: "TRADING_ACTION_HALT",
+		2: "TRADING_ACTION_RESUME",
+		3: "TRADING_ACTION_FLATTEN",
+	}
+	TradingAction_value = map[string]int32{
+		"TRADING_ACTION_UNSPECIFIED": 0,
+		"TRADING_ACTION_HALT":        1,
+		"TRADING_ACTION_RESUME":      2,
+		"TRADING_ACTION_FLATTEN":     3,
+	}
+)
+
+func (x TradingAction) Enum() *TradingAction {
+	p := new(TradingAction)
+	*p = x
+	return p
+}
+
+func (x TradingAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TradingAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_bot_v1_bot_proto_enumTypes[10].Descriptor()
+}
+
+func (TradingAction) Type() protoreflect.EnumType {
+	return &file_bot_v1_bot_proto_enumTypes[10]
+}
+
+func (x TradingAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TradingAction.Descriptor instead.
+func (TradingAction) EnumDescriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{10}
+}
+
+type FlattenType int32
+
+const (
+	// keep the open positions
+	FlattenType_FLATTEN_TYPE_UNSPECIFIED FlattenType = 0
+	// close all open positions of bots by market orders
+	FlattenType_FLATTEN_TYPE_MARKET FlattenType = 1
+	// close all open positions of bots by limit orders at mid
+	FlattenType_FLATTEN_TYPE_LIMIT FlattenType = 2
+)
+
+// Enum value maps for FlattenType.
+var (
+	FlattenType_name = map[int32]string{
+		0: "FLATTEN_TYPE_UNSPECIFIED",
+		1: "FLATTEN_TYPE_MARKET",
+		2: "FLATTEN_TYPE_LIMIT",
+	}
+	FlattenType_value = map[string]int32{
+		"FLATTEN_TYPE_UNSPECIFIED": 0,
+		"FLATTEN_TYPE_MARKET":      1,
+		"FLATTEN_TYPE_LIMIT":       2,
+	}
+)
+
+func (x FlattenType) Enum() *FlattenType {
+	p := new(FlattenType)
+	*p = x
+	return p
+}
+
+func (x FlattenType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlattenType) Descriptor() protoreflect.EnumDescriptor {
+	return file_bot_v1_bot_proto_enumTypes[11].Descriptor()
+}
+
+func (FlattenType) Type() protoreflect.EnumType {
+	return &file_bot_v1_bot_proto_enumTypes[11]
+}
+
+func (x FlattenType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlattenType.Descriptor instead.
+func (FlattenType) EnumDescriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{11}
+}
+
 type DoubleRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -406,8 +769,11 @@ type Strike struct {
 	Price float64 `protobuf:"fixed64,13,opt,name=price,proto3" json:"price,omitempty"`
 	// limit the price range
 	PriceRange *DoubleRange `protobuf:"bytes,14,opt,name=price_range,json=priceRange,proto3" json:"price_range,omitempty"`
-	// offset to the strike chosen above, e.g +10, -10
+	// offset to the strike chosen above, e.g +10, -10, an illiquid strike at the offset falls back to
+	// the nearest liquid one within one more offset beyond it
 	StrikeOffset float64 `protobuf:"fixed64,21,opt,name=strike_offset,json=strikeOffset,proto3" json:"strike_offset,omitempty"`
+	// strikes failing it are skipped, so the next best strike is chosen
+	Liquidity *Liquidity `protobuf:"bytes,31,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
 }
 
 func (x *Strike) Reset() {
@@ -491,6 +857,105 @@ func (x *Strike) GetStrikeOffset() float64 {
 	return 0
 }
 
+func (x *Strike) GetLiquidity() *Liquidity {
+	if x != nil {
+		return x.Liquidity
+	}
+	return nil
+}
+
+// Liquidity of a strike, zero values are unbounded
+type Liquidity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max ask - bid per share
+	MaxSpread float64 `protobuf:"fixed64,1,opt,name=max_spread,json=maxSpread,proto3" json:"max_spread,omitempty"`
+	// max (ask - bid) / mid in percent, e.g. 10 for 10%
+	MaxSpreadPercent float64 `protobuf:"fixed64,2,opt,name=max_spread_percent,json=maxSpreadPercent,proto3" json:"max_spread_percent,omitempty"`
+	MinBid           float64 `protobuf:"fixed64,3,opt,name=min_bid,json=minBid,proto3" json:"min_bid,omitempty"`
+	// skipped with a warning if the source reports no open interest at all, e.g. alpaca
+	MinOpenInterest int64 `protobuf:"varint,4,opt,name=min_open_interest,json=minOpenInterest,proto3" json:"min_open_interest,omitempty"`
+	MinVolume       int64 `protobuf:"varint,5,opt,name=min_volume,json=minVolume,proto3" json:"min_volume,omitempty"`
+	// max age in seconds of the bid and the ask, a quote without any time is stale
+	MaxQuoteAge int32 `protobuf:"varint,6,opt,name=max_quote_age,json=maxQuoteAge,proto3" json:"max_quote_age,omitempty"`
+}
+
+func (x *Liquidity) Reset() {
+	*x = Liquidity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Liquidity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Liquidity) ProtoMessage() {}
+
+func (x *Liquidity) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Liquidity.ProtoReflect.Descriptor instead.
+func (*Liquidity) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Liquidity) GetMaxSpread() float64 {
+	if x != nil {
+		return x.MaxSpread
+	}
+	return 0
+}
+
+func (x *Liquidity) GetMaxSpreadPercent() float64 {
+	if x != nil {
+		return x.MaxSpreadPercent
+	}
+	return 0
+}
+
+func (x *Liquidity) GetMinBid() float64 {
+	if x != nil {
+		return x.MinBid
+	}
+	return 0
+}
+
+func (x *Liquidity) GetMinOpenInterest() int64 {
+	if x != nil {
+		return x.MinOpenInterest
+	}
+	return 0
+}
+
+func (x *Liquidity) GetMinVolume() int64 {
+	if x != nil {
+		return x.MinVolume
+	}
+	return 0
+}
+
+func (x *Liquidity) GetMaxQuoteAge() int32 {
+	if x != nil {
+		return x.MaxQuoteAge
+	}
+	return 0
+}
+
 type DTE struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -507,7 +972,7 @@ type DTE struct {
 func (x *DTE) Reset() {
 	*x = DTE{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bot_v1_bot_proto_msgTypes[3]
+		mi := &file_bot_v1_bot_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -520,7 +985,7 @@ func (x *DTE) String() string {
 func (*DTE) ProtoMessage() {}
 
 func (x *DTE) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_v1_bot_proto_msgTypes[3]
+	mi := &file_bot_v1_bot_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +998,7 @@ func (x *DTE) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DTE.ProtoReflect.Descriptor instead.
 func (*DTE) Descriptor() ([]byte, []int) {
-	return file_bot_v1_bot_proto_rawDescGZIP(), []int{3}
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DTE) GetMatch() Match {
@@ -577,7 +1042,7 @@ type Leg struct {
 func (x *Leg) Reset() {
 	*x = Leg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bot_v1_bot_proto_msgTypes[4]
+		mi := &file_bot_v1_bot_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -590,7 +1055,7 @@ func (x *Leg) String() string {
 func (*Leg) ProtoMessage() {}
 
 func (x *Leg) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_v1_bot_proto_msgTypes[4]
+	mi := &file_bot_v1_bot_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,7 +1068,7 @@ func (x *Leg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Leg.ProtoReflect.Descriptor instead.
 func (*Leg) Descriptor() ([]byte, []int) {
-	return file_bot_v1_bot_proto_rawDescGZIP(), []int{4}
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Leg) GetAction() Action {
@@ -656,7 +1121,7 @@ type Allocation struct {
 func (x *Allocation) Reset() {
 	*x = Allocation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bot_v1_bot_proto_msgTypes[5]
+		mi := &file_bot_v1_bot_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -669,7 +1134,7 @@ func (x *Allocation) String() string {
 func (*Allocation) ProtoMessage() {}
 
 func (x *Allocation) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_v1_bot_proto_msgTypes[5]
+	mi := &file_bot_v1_bot_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -682,7 +1147,7 @@ func (x *Allocation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Allocation.ProtoReflect.Descriptor instead.
 func (*Allocation) Descriptor() ([]byte, []int) {
-	return file_bot_v1_bot_proto_rawDescGZIP(), []int{5}
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Allocation) GetAllocator() Allocator {
@@ -721,7 +1186,7 @@ type WeekdaysChooser struct {
 func (x *WeekdaysChooser) Reset() {
 	*x = WeekdaysChooser{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bot_v1_bot_proto_msgTypes[6]
+		mi := &file_bot_v1_bot_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -734,7 +1199,7 @@ func (x *WeekdaysChooser) String() string {
 func (*WeekdaysChooser) ProtoMessage() {}
 
 func (x *WeekdaysChooser) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_v1_bot_proto_msgTypes[6]
+	mi := &file_bot_v1_bot_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -747,7 +1212,7 @@ func (x *WeekdaysChooser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeekdaysChooser.ProtoReflect.Descriptor instead.
 func (*WeekdaysChooser) Descriptor() ([]byte, []int) {
-	return file_bot_v1_bot_proto_rawDescGZIP(), []int{6}
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *WeekdaysChooser) GetMonday() bool {
@@ -797,7 +1262,7 @@ type Time struct {
 func (x *Time) Reset() {
 	*x = Time{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bot_v1_bot_proto_msgTypes[7]
+		mi := &file_bot_v1_bot_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -810,7 +1275,7 @@ func (x *Time) String() string {
 func (*Time) ProtoMessage() {}
 
 func (x *Time) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_v1_bot_proto_msgTypes[7]
+	mi := &file_bot_v1_bot_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +1288,7 @@ func (x *Time) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Time.ProtoReflect.Descriptor instead.
 func (*Time) Descriptor() ([]byte, []int) {
-	return file_bot_v1_bot_proto_rawDescGZIP(), []int{7}
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Time) GetHour() int32 {
@@ -840,32 +1305,33 @@ func (x *Time) GetMinute() int32 {
 	return 0
 }
 
-type Entry struct {
+// Condition passes if the value is between min and max, an absent bound is unbounded
+type Condition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Weekdays *WeekdaysChooser `protobuf:"bytes,1,opt,name=weekdays,proto3" json:"weekdays,omitempty"`
-	Time     *Time            `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Min *float64 `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max *float64 `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
 }
 
-func (x *Entry) Reset() {
-	*x = Entry{}
+func (x *Condition) Reset() {
+	*x = Condition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bot_v1_bot_proto_msgTypes[8]
+		mi := &file_bot_v1_bot_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Entry) String() string {
+func (x *Condition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Entry) ProtoMessage() {}
+func (*Condition) ProtoMessage() {}
 
-func (x *Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_v1_bot_proto_msgTypes[8]
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,53 +1342,53 @@ func (x *Entry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Entry.ProtoReflect.Descriptor instead.
-func (*Entry) Descriptor() ([]byte, []int) {
-	return file_bot_v1_bot_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Entry) GetWeekdays() *WeekdaysChooser {
-	if x != nil {
-		return x.Weekdays
+func (x *Condition) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
 	}
-	return nil
+	return 0
 }
 
-func (x *Entry) GetTime() *Time {
-	if x != nil {
-		return x.Time
+func (x *Condition) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
 	}
-	return nil
+	return 0
 }
 
-type Exit struct {
+// Interval enters every some minutes from a time to another, both inclusive
+type Interval struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Dte      int32   `protobuf:"varint,1,opt,name=dte,proto3" json:"dte,omitempty"`
-	Time     *Time   `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
-	StopWin  float64 `protobuf:"fixed64,11,opt,name=stop_win,json=stopWin,proto3" json:"stop_win,omitempty"`
-	StopLoss float64 `protobuf:"fixed64,12,opt,name=stop_loss,json=stopLoss,proto3" json:"stop_loss,omitempty"`
+	From         *Time `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To           *Time `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	EveryMinutes int32 `protobuf:"varint,3,opt,name=every_minutes,json=everyMinutes,proto3" json:"every_minutes,omitempty"`
 }
 
-func (x *Exit) Reset() {
-	*x = Exit{}
+func (x *Interval) Reset() {
+	*x = Interval{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bot_v1_bot_proto_msgTypes[9]
+		mi := &file_bot_v1_bot_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Exit) String() string {
+func (x *Interval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Exit) ProtoMessage() {}
+func (*Interval) ProtoMessage() {}
 
-func (x *Exit) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_v1_bot_proto_msgTypes[9]
+func (x *Interval) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,73 +1399,73 @@ func (x *Exit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Exit.ProtoReflect.Descriptor instead.
-func (*Exit) Descriptor() ([]byte, []int) {
-	return file_bot_v1_bot_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *Exit) GetDte() int32 {
-	if x != nil {
-		return x.Dte
-	}
-	return 0
+// Deprecated: Use Interval.ProtoReflect.Descriptor instead.
+func (*Interval) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Exit) GetTime() *Time {
+func (x *Interval) GetFrom() *Time {
 	if x != nil {
-		return x.Time
+		return x.From
 	}
 	return nil
 }
 
-func (x *Exit) GetStopWin() float64 {
+func (x *Interval) GetTo() *Time {
 	if x != nil {
-		return x.StopWin
+		return x.To
 	}
-	return 0
+	return nil
 }
 
-func (x *Exit) GetStopLoss() float64 {
+func (x *Interval) GetEveryMinutes() int32 {
 	if x != nil {
-		return x.StopLoss
+		return x.EveryMinutes
 	}
 	return 0
 }
 
-type Setting struct {
+type Entry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// underlying symbol, e.g SPX, SPY
-	Underlying string `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
-	// legs setting
-	Legs []*Leg `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
-	// allocation
-	Allocation *Allocation `protobuf:"bytes,3,opt,name=allocation,proto3" json:"allocation,omitempty"`
-	// entry condition
-	Entry *Entry `protobuf:"bytes,4,opt,name=entry,proto3" json:"entry,omitempty"`
-	// exit condition
-	Exit *Exit `protobuf:"bytes,5,opt,name=exit,proto3" json:"exit,omitempty"`
+	Weekdays *WeekdaysChooser `protobuf:"bytes,1,opt,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// entry times of a day in New York, time, times and interval are merged
+	Time     *Time     `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Times    []*Time   `protobuf:"bytes,3,rep,name=times,proto3" json:"times,omitempty"`
+	Interval *Interval `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// conditions by market data at the entry time, absent ones always pass
+	// VIX index level
+	Vix *Condition `protobuf:"bytes,11,opt,name=vix,proto3" json:"vix,omitempty"`
+	// IV rank of the underlying in percent, where the current at-the-money IV is between
+	// the lowest and highest of the lookback
+	IvRank *Condition `protobuf:"bytes,12,opt,name=iv_rank,json=ivRank,proto3" json:"iv_rank,omitempty"`
+	// IV percentile of the underlying in percent, the share of the lookback days with a lower IV
+	IvPercentile *Condition `protobuf:"bytes,13,opt,name=iv_percentile,json=ivPercentile,proto3" json:"iv_percentile,omitempty"`
+	// change of the underlying on the day in percent, e.g. -1 for down 1%
+	DayChange *Condition `protobuf:"bytes,14,opt,name=day_change,json=dayChange,proto3" json:"day_change,omitempty"`
+	// overnight gap of the underlying in percent, the open vs the previous close
+	Gap *Condition `protobuf:"bytes,15,opt,name=gap,proto3" json:"gap,omitempty"`
 }
 
-func (x *Setting) Reset() {
-	*x = Setting{}
+func (x *Entry) Reset() {
+	*x = Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_bot_v1_bot_proto_msgTypes[10]
+		mi := &file_bot_v1_bot_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Setting) String() string {
+func (x *Entry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Setting) ProtoMessage() {}
+func (*Entry) ProtoMessage() {}
 
-func (x *Setting) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_v1_bot_proto_msgTypes[10]
+func (x *Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,113 +1476,88 @@ func (x *Setting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Setting.ProtoReflect.Descriptor instead.
-func (*Setting) Descriptor() ([]byte, []int) {
-	return file_bot_v1_bot_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Entry.ProtoReflect.Descriptor instead.
+func (*Entry) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Setting) GetUnderlying() string {
+func (x *Entry) GetWeekdays() *WeekdaysChooser {
 	if x != nil {
-		return x.Underlying
+		return x.Weekdays
 	}
-	return ""
+	return nil
 }
 
-func (x *Setting) GetLegs() []*Leg {
+func (x *Entry) GetTime() *Time {
 	if x != nil {
-		return x.Legs
+		return x.Time
 	}
 	return nil
 }
 
-func (x *Setting) GetAllocation() *Allocation {
+func (x *Entry) GetTimes() []*Time {
 	if x != nil {
-		return x.Allocation
+		return x.Times
 	}
 	return nil
 }
 
-func (x *Setting) GetEntry() *Entry {
+func (x *Entry) GetInterval() *Interval {
 	if x != nil {
-		return x.Entry
+		return x.Interval
 	}
 	return nil
 }
 
-func (x *Setting) GetExit() *Exit {
+func (x *Entry) GetVix() *Condition {
 	if x != nil {
-		return x.Exit
+		return x.Vix
 	}
 	return nil
 }
 
-type CreateRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Setting *Setting `protobuf:"bytes,2,opt,name=setting,proto3" json:"setting,omitempty"`
-}
-
-func (x *CreateRequest) Reset() {
-	*x = CreateRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_bot_v1_bot_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Entry) GetIvRank() *Condition {
+	if x != nil {
+		return x.IvRank
 	}
+	return nil
 }
 
-func (x *CreateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateRequest) ProtoMessage() {}
-
-func (x *CreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bot_v1_bot_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Entry) GetIvPercentile() *Condition {
+	if x != nil {
+		return x.IvPercentile
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
-func (*CreateRequest) Descriptor() ([]byte, []int) {
-	return file_bot_v1_bot_proto_rawDescGZIP(), []int{11}
+	return nil
 }
 
-func (x *CreateRequest) GetName() string {
+func (x *Entry) GetDayChange() *Condition {
 	if x != nil {
-		return x.Name
+		return x.DayChange
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateRequest) GetSetting() *Setting {
+func (x *Entry) GetGap() *Condition {
 	if x != nil {
-		return x.Setting
+		return x.Gap
 	}
 	return nil
 }
 
-type CreateResponse struct {
+// ProfitTarget is a step of a scale-out ladder
+type ProfitTarget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Setting *Setting `protobuf:"bytes,3,opt,name=setting,proto3" json:"setting,omitempty"`
+	// the profit to close at, in the unit of the exit
+	Profit float64 `protobuf:"fixed64,1,opt,name=profit,proto3" json:"profit,omitempty"`
+	// percent of the size at the open to close, 0 or 100 closes all the rest
+	ClosePercent float64 `protobuf:"fixed64,2,opt,name=close_percent,json=closePercent,proto3" json:"close_percent,omitempty"`
 }
 
-func (x *CreateResponse) Reset() {
-	*x = CreateResponse{}
+func (x *ProfitTarget) Reset() {
+	*x = ProfitTarget{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bot_v1_bot_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1124,13 +1565,13 @@ func (x *CreateResponse) Reset() {
 	}
 }
 
-func (x *CreateResponse) String() string {
+func (x *ProfitTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateResponse) ProtoMessage() {}
+func (*ProfitTarget) ProtoMessage() {}
 
-func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+func (x *ProfitTarget) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_v1_bot_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1142,42 +1583,37 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
-func (*CreateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProfitTarget.ProtoReflect.Descriptor instead.
+func (*ProfitTarget) Descriptor() ([]byte, []int) {
 	return file_bot_v1_bot_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CreateResponse) GetName() string {
+func (x *ProfitTarget) GetProfit() float64 {
 	if x != nil {
-		return x.Name
+		return x.Profit
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateResponse) GetSetting() *Setting {
+func (x *ProfitTarget) GetClosePercent() float64 {
 	if x != nil {
-		return x.Setting
+		return x.ClosePercent
 	}
-	return nil
+	return 0
 }
 
-type GetRequest struct {
+// TrailingStop closes after the profit gives back trail once it has reached activation
+type TrailingStop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Activation float64 `protobuf:"fixed64,1,opt,name=activation,proto3" json:"activation,omitempty"`
+	Trail      float64 `protobuf:"fixed64,2,opt,name=trail,proto3" json:"trail,omitempty"`
 }
 
-func (x *GetRequest) Reset() {
-	*x = GetRequest{}
+func (x *TrailingStop) Reset() {
+	*x = TrailingStop{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bot_v1_bot_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1185,13 +1621,13 @@ func (x *GetRequest) Reset() {
 	}
 }
 
-func (x *GetRequest) String() string {
+func (x *TrailingStop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRequest) ProtoMessage() {}
+func (*TrailingStop) ProtoMessage() {}
 
-func (x *GetRequest) ProtoReflect() protoreflect.Message {
+func (x *TrailingStop) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_v1_bot_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1203,30 +1639,48 @@ func (x *GetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
-func (*GetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrailingStop.ProtoReflect.Descriptor instead.
+func (*TrailingStop) Descriptor() ([]byte, []int) {
 	return file_bot_v1_bot_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetRequest) GetId() string {
+func (x *TrailingStop) GetActivation() float64 {
 	if x != nil {
-		return x.Id
+		return x.Activation
 	}
-	return ""
+	return 0
 }
 
-type GetResponse struct {
+func (x *TrailingStop) GetTrail() float64 {
+	if x != nil {
+		return x.Trail
+	}
+	return 0
+}
+
+type Exit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Setting *Setting `protobuf:"bytes,3,opt,name=setting,proto3" json:"setting,omitempty"`
+	// close when the nearest leg's DTE is at most dte, at time of the day if set
+	Dte  int32 `protobuf:"varint,1,opt,name=dte,proto3" json:"dte,omitempty"`
+	Time *Time `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// close all at the profit, 0 means no target
+	StopWin float64 `protobuf:"fixed64,11,opt,name=stop_win,json=stopWin,proto3" json:"stop_win,omitempty"`
+	// close all at the loss, a positive number, 0 means no stop
+	StopLoss float64 `protobuf:"fixed64,12,opt,name=stop_loss,json=stopLoss,proto3" json:"stop_loss,omitempty"`
+	// the unit of stop_win, stop_loss, profit_targets and trailing_stop
+	Unit TargetUnit `protobuf:"varint,13,opt,name=unit,proto3,enum=bot.v1.TargetUnit" json:"unit,omitempty"`
+	// the scale-out ladder, ascending by profit
+	ProfitTargets []*ProfitTarget `protobuf:"bytes,14,rep,name=profit_targets,json=profitTargets,proto3" json:"profit_targets,omitempty"`
+	TrailingStop  *TrailingStop   `protobuf:"bytes,15,opt,name=trailing_stop,json=trailingStop,proto3" json:"trailing_stop,omitempty"`
+	// close when the absolute delta of any short leg exceeds it, 0 means no limit
+	MaxShortDelta float64 `protobuf:"fixed64,16,opt,name=max_short_delta,json=maxShortDelta,proto3" json:"max_short_delta,omitempty"`
 }
 
-func (x *GetResponse) Reset() {
-	*x = GetResponse{}
+func (x *Exit) Reset() {
+	*x = Exit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_bot_v1_bot_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1234,13 +1688,13 @@ func (x *GetResponse) Reset() {
 	}
 }
 
-func (x *GetResponse) String() string {
+func (x *Exit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResponse) ProtoMessage() {}
+func (*Exit) ProtoMessage() {}
 
-func (x *GetResponse) ProtoReflect() protoreflect.Message {
+func (x *Exit) ProtoReflect() protoreflect.Message {
 	mi := &file_bot_v1_bot_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1252,43 +1706,2632 @@ func (x *GetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
-func (*GetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Exit.ProtoReflect.Descriptor instead.
+func (*Exit) Descriptor() ([]byte, []int) {
 	return file_bot_v1_bot_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetResponse) GetId() string {
+func (x *Exit) GetDte() int32 {
 	if x != nil {
-		return x.Id
+		return x.Dte
 	}
-	return ""
+	return 0
 }
 
-func (x *GetResponse) GetName() string {
+func (x *Exit) GetTime() *Time {
 	if x != nil {
-		return x.Name
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-func (x *GetResponse) GetSetting() *Setting {
+func (x *Exit) GetStopWin() float64 {
 	if x != nil {
-		return x.Setting
+		return x.StopWin
 	}
-	return nil
+	return 0
 }
 
-var File_bot_v1_bot_proto protoreflect.FileDescriptor
+func (x *Exit) GetStopLoss() float64 {
+	if x != nil {
+		return x.StopLoss
+	}
+	return 0
+}
 
-var file_bot_v1_bot_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x62, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+func (x *Exit) GetUnit() TargetUnit {
+	if x != nil {
+		return x.Unit
+	}
+	return TargetUnit_TARGET_UNIT_UNSPECIFIED
+}
+
+func (x *Exit) GetProfitTargets() []*ProfitTarget {
+	if x != nil {
+		return x.ProfitTargets
+	}
+	return nil
+}
+
+func (x *Exit) GetTrailingStop() *TrailingStop {
+	if x != nil {
+		return x.TrailingStop
+	}
+	return nil
+}
+
+func (x *Exit) GetMaxShortDelta() float64 {
+	if x != nil {
+		return x.MaxShortDelta
+	}
+	return 0
+}
+
+// Adjustment rolls the tested side of a position, i.e. the legs of the tested short leg's option type,
+// out in time and/or away in strike by a single order, instead of closing the position
+type Adjustment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// a short leg is tested if its absolute delta is above it, 0 means no trigger
+	TriggerDelta float64 `protobuf:"fixed64,1,opt,name=trigger_delta,json=triggerDelta,proto3" json:"trigger_delta,omitempty"`
+	// or the underlying is within the points of its strike, 0 means no trigger
+	TriggerDistance float64 `protobuf:"fixed64,2,opt,name=trigger_distance,json=triggerDistance,proto3" json:"trigger_distance,omitempty"`
+	// the expiration to roll to, the same expiration if absent
+	RollDte *DTE `protobuf:"bytes,3,opt,name=roll_dte,json=rollDte,proto3" json:"roll_dte,omitempty"`
+	// points to move the strikes away from the underlying, down for puts and up for calls
+	StrikeShift float64 `protobuf:"fixed64,4,opt,name=strike_shift,json=strikeShift,proto3" json:"strike_shift,omitempty"`
+	// max rolls of a position, 0 means the default of the server
+	MaxRolls int32 `protobuf:"varint,5,opt,name=max_rolls,json=maxRolls,proto3" json:"max_rolls,omitempty"`
+	// only roll for a net credit
+	RequireCredit bool `protobuf:"varint,6,opt,name=require_credit,json=requireCredit,proto3" json:"require_credit,omitempty"`
+	// minutes a rolled position waits before rolling again, 0 means the default of the server
+	CooldownMinutes int32 `protobuf:"varint,7,opt,name=cooldown_minutes,json=cooldownMinutes,proto3" json:"cooldown_minutes,omitempty"`
+}
+
+func (x *Adjustment) Reset() {
+	*x = Adjustment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Adjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Adjustment) ProtoMessage() {}
+
+func (x *Adjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Adjustment.ProtoReflect.Descriptor instead.
+func (*Adjustment) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Adjustment) GetTriggerDelta() float64 {
+	if x != nil {
+		return x.TriggerDelta
+	}
+	return 0
+}
+
+func (x *Adjustment) GetTriggerDistance() float64 {
+	if x != nil {
+		return x.TriggerDistance
+	}
+	return 0
+}
+
+func (x *Adjustment) GetRollDte() *DTE {
+	if x != nil {
+		return x.RollDte
+	}
+	return nil
+}
+
+func (x *Adjustment) GetStrikeShift() float64 {
+	if x != nil {
+		return x.StrikeShift
+	}
+	return 0
+}
+
+func (x *Adjustment) GetMaxRolls() int32 {
+	if x != nil {
+		return x.MaxRolls
+	}
+	return 0
+}
+
+func (x *Adjustment) GetRequireCredit() bool {
+	if x != nil {
+		return x.RequireCredit
+	}
+	return false
+}
+
+func (x *Adjustment) GetCooldownMinutes() int32 {
+	if x != nil {
+		return x.CooldownMinutes
+	}
+	return 0
+}
+
+// Expiry tells how to handle the legs expiring, the legs left to the settlement are always recorded
+type Expiry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy ExpiryPolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=bot.v1.ExpiryPolicy" json:"policy,omitempty"`
+	// minutes before the last trade time to close, 0 means the default of the server
+	MinutesBeforeClose int32 `protobuf:"varint,2,opt,name=minutes_before_close,json=minutesBeforeClose,proto3" json:"minutes_before_close,omitempty"`
+	// a short leg whose strike is within the points of the underlying is pinned, and closed like an ITM one
+	PinDistance float64 `protobuf:"fixed64,3,opt,name=pin_distance,json=pinDistance,proto3" json:"pin_distance,omitempty"`
+}
+
+func (x *Expiry) Reset() {
+	*x = Expiry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Expiry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expiry) ProtoMessage() {}
+
+func (x *Expiry) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expiry.ProtoReflect.Descriptor instead.
+func (*Expiry) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Expiry) GetPolicy() ExpiryPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return ExpiryPolicy_EXPIRY_POLICY_UNSPECIFIED
+}
+
+func (x *Expiry) GetMinutesBeforeClose() int32 {
+	if x != nil {
+		return x.MinutesBeforeClose
+	}
+	return 0
+}
+
+func (x *Expiry) GetPinDistance() float64 {
+	if x != nil {
+		return x.PinDistance
+	}
+	return 0
+}
+
+type Setting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// underlying symbol, e.g SPX, SPY
+	Underlying string `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	// legs setting
+	Legs []*Leg `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	// allocation
+	Allocation *Allocation `protobuf:"bytes,3,opt,name=allocation,proto3" json:"allocation,omitempty"`
+	// entry condition
+	Entry *Entry `protobuf:"bytes,4,opt,name=entry,proto3" json:"entry,omitempty"`
+	// exit condition
+	Exit *Exit `protobuf:"bytes,5,opt,name=exit,proto3" json:"exit,omitempty"`
+	// max simultaneous open positions of the bot, 0 means unlimited
+	MaxOpenPositions int32 `protobuf:"varint,6,opt,name=max_open_positions,json=maxOpenPositions,proto3" json:"max_open_positions,omitempty"`
+	// adjustments of open positions, the first triggered one applies, evaluated before the exit
+	Adjustments []*Adjustment `protobuf:"bytes,7,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	Expiry      *Expiry       `protobuf:"bytes,8,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (x *Setting) Reset() {
+	*x = Setting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Setting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Setting) ProtoMessage() {}
+
+func (x *Setting) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Setting.ProtoReflect.Descriptor instead.
+func (*Setting) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Setting) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *Setting) GetLegs() []*Leg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *Setting) GetAllocation() *Allocation {
+	if x != nil {
+		return x.Allocation
+	}
+	return nil
+}
+
+func (x *Setting) GetEntry() *Entry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *Setting) GetExit() *Exit {
+	if x != nil {
+		return x.Exit
+	}
+	return nil
+}
+
+func (x *Setting) GetMaxOpenPositions() int32 {
+	if x != nil {
+		return x.MaxOpenPositions
+	}
+	return 0
+}
+
+func (x *Setting) GetAdjustments() []*Adjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+func (x *Setting) GetExpiry() *Expiry {
+	if x != nil {
+		return x.Expiry
+	}
+	return nil
+}
+
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// why the last order was blocked by the risk checks, empty if it passed
+	RiskViolations []string `protobuf:"bytes,1,rep,name=risk_violations,json=riskViolations,proto3" json:"risk_violations,omitempty"`
+	RiskCheckedAt  int64    `protobuf:"varint,2,opt,name=risk_checked_at,json=riskCheckedAt,proto3" json:"risk_checked_at,omitempty"` // unix timestamp in ms
+	// the last time the bot tried to open a position
+	LastEntryAt int64 `protobuf:"varint,3,opt,name=last_entry_at,json=lastEntryAt,proto3" json:"last_entry_at,omitempty"` // unix timestamp in ms
+	// the error of the last entry, empty if it succeeded
+	LastEntryError string `protobuf:"bytes,4,opt,name=last_entry_error,json=lastEntryError,proto3" json:"last_entry_error,omitempty"`
+	// stock positions of the account in the underlying of the bot, likely from an assignment
+	AssignmentAlerts    []string `protobuf:"bytes,5,rep,name=assignment_alerts,json=assignmentAlerts,proto3" json:"assignment_alerts,omitempty"`
+	AssignmentCheckedAt int64    `protobuf:"varint,6,opt,name=assignment_checked_at,json=assignmentCheckedAt,proto3" json:"assignment_checked_at,omitempty"` // unix timestamp in ms
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Status) GetRiskViolations() []string {
+	if x != nil {
+		return x.RiskViolations
+	}
+	return nil
+}
+
+func (x *Status) GetRiskCheckedAt() int64 {
+	if x != nil {
+		return x.RiskCheckedAt
+	}
+	return 0
+}
+
+func (x *Status) GetLastEntryAt() int64 {
+	if x != nil {
+		return x.LastEntryAt
+	}
+	return 0
+}
+
+func (x *Status) GetLastEntryError() string {
+	if x != nil {
+		return x.LastEntryError
+	}
+	return ""
+}
+
+func (x *Status) GetAssignmentAlerts() []string {
+	if x != nil {
+		return x.AssignmentAlerts
+	}
+	return nil
+}
+
+func (x *Status) GetAssignmentCheckedAt() int64 {
+	if x != nil {
+		return x.AssignmentCheckedAt
+	}
+	return 0
+}
+
+type PositionLeg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// option symbol, e.g. SPXW240119C04700000
+	Symbol     string     `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	RootSymbol string     `protobuf:"bytes,2,opt,name=root_symbol,json=rootSymbol,proto3" json:"root_symbol,omitempty"`
+	OptionType OptionType `protobuf:"varint,3,opt,name=option_type,json=optionType,proto3,enum=bot.v1.OptionType" json:"option_type,omitempty"`
+	Strike     float64    `protobuf:"fixed64,4,opt,name=strike,proto3" json:"strike,omitempty"`
+	Expiration string     `protobuf:"bytes,5,opt,name=expiration,proto3" json:"expiration,omitempty"` // yyyy-mm-dd
+	// signed quantity, positive for long and negative for short
+	Quantity int32 `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// fill price per share
+	OpenPrice float64 `protobuf:"fixed64,7,opt,name=open_price,json=openPrice,proto3" json:"open_price,omitempty"`
+	// fill price per share, or the settlement value if it expired
+	ClosePrice float64 `protobuf:"fixed64,8,opt,name=close_price,json=closePrice,proto3" json:"close_price,omitempty"`
+	// settled at the expiration instead of closed by an order
+	Expired bool `protobuf:"varint,9,opt,name=expired,proto3" json:"expired,omitempty"`
+	// a physically settled leg expired in the money, so shares are delivered, see the assignment alerts
+	Assigned bool `protobuf:"varint,10,opt,name=assigned,proto3" json:"assigned,omitempty"`
+}
+
+func (x *PositionLeg) Reset() {
+	*x = PositionLeg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PositionLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionLeg) ProtoMessage() {}
+
+func (x *PositionLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionLeg.ProtoReflect.Descriptor instead.
+func (*PositionLeg) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PositionLeg) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PositionLeg) GetRootSymbol() string {
+	if x != nil {
+		return x.RootSymbol
+	}
+	return ""
+}
+
+func (x *PositionLeg) GetOptionType() OptionType {
+	if x != nil {
+		return x.OptionType
+	}
+	return OptionType_OPTION_TYPE_UNSPECIFIED
+}
+
+func (x *PositionLeg) GetStrike() float64 {
+	if x != nil {
+		return x.Strike
+	}
+	return 0
+}
+
+func (x *PositionLeg) GetExpiration() string {
+	if x != nil {
+		return x.Expiration
+	}
+	return ""
+}
+
+func (x *PositionLeg) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PositionLeg) GetOpenPrice() float64 {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return 0
+}
+
+func (x *PositionLeg) GetClosePrice() float64 {
+	if x != nil {
+		return x.ClosePrice
+	}
+	return 0
+}
+
+func (x *PositionLeg) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *PositionLeg) GetAssigned() bool {
+	if x != nil {
+		return x.Assigned
+	}
+	return false
+}
+
+type Position struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BotId string `protobuf:"bytes,2,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	// the account holding the position
+	AccountId  string         `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Underlying string         `protobuf:"bytes,4,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	Legs       []*PositionLeg `protobuf:"bytes,5,rep,name=legs,proto3" json:"legs,omitempty"`
+	Status     PositionStatus `protobuf:"varint,6,opt,name=status,proto3,enum=bot.v1.PositionStatus" json:"status,omitempty"`
+	OpenedAt   int64          `protobuf:"varint,7,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"` // unix timestamp in ms
+	ClosedAt   int64          `protobuf:"varint,8,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"` // unix timestamp in ms
+	// net fill price per share of one unit, positive for debit and negative for credit
+	OpenPrice  float64 `protobuf:"fixed64,9,opt,name=open_price,json=openPrice,proto3" json:"open_price,omitempty"`
+	ClosePrice float64 `protobuf:"fixed64,10,opt,name=close_price,json=closePrice,proto3" json:"close_price,omitempty"`
+	// number of units, legs' quantities already include it
+	Size int32 `protobuf:"varint,11,opt,name=size,proto3" json:"size,omitempty"`
+	// the max loss in dollars if the worst case happens, -1 for undefined risk
+	MaxLoss float64 `protobuf:"fixed64,12,opt,name=max_loss,json=maxLoss,proto3" json:"max_loss,omitempty"`
+	// realized profit and loss in dollars after closed
+	RealizedPnl float64 `protobuf:"fixed64,13,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	// the position this one is split from by a partial close
+	ParentId string `protobuf:"bytes,14,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// the size at the open, scale-out ladders are relative to it
+	InitialSize int32 `protobuf:"varint,15,opt,name=initial_size,json=initialSize,proto3" json:"initial_size,omitempty"`
+	// number of the profit targets of the ladder executed
+	TargetsHit int32 `protobuf:"varint,16,opt,name=targets_hit,json=targetsHit,proto3" json:"targets_hit,omitempty"`
+	// the highest profit in dollars of one unit seen, for trailing stops
+	PeakUnitPnl float64 `protobuf:"fixed64,17,opt,name=peak_unit_pnl,json=peakUnitPnl,proto3" json:"peak_unit_pnl,omitempty"`
+	// why the position is closed
+	CloseReason string `protobuf:"bytes,18,opt,name=close_reason,json=closeReason,proto3" json:"close_reason,omitempty"`
+	// the position this one is rolled from, a rolled position is closed with a zero realized pnl
+	// and the net price of the roll is added to the open price of the new one
+	RolledFrom string `protobuf:"bytes,19,opt,name=rolled_from,json=rolledFrom,proto3" json:"rolled_from,omitempty"`
+	// number of rolls from the original position
+	Rolls int32 `protobuf:"varint,20,opt,name=rolls,proto3" json:"rolls,omitempty"`
+	// legs settled at their expiration while the others stay open, their settlement values are
+	// deducted from the open price
+	ExpiredLegs []*PositionLeg `protobuf:"bytes,21,rep,name=expired_legs,json=expiredLegs,proto3" json:"expired_legs,omitempty"`
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Position) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Position) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *Position) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Position) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *Position) GetLegs() []*PositionLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *Position) GetStatus() PositionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PositionStatus_POSITION_STATUS_UNSPECIFIED
+}
+
+func (x *Position) GetOpenedAt() int64 {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return 0
+}
+
+func (x *Position) GetClosedAt() int64 {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return 0
+}
+
+func (x *Position) GetOpenPrice() float64 {
+	if x != nil {
+		return x.OpenPrice
+	}
+	return 0
+}
+
+func (x *Position) GetClosePrice() float64 {
+	if x != nil {
+		return x.ClosePrice
+	}
+	return 0
+}
+
+func (x *Position) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Position) GetMaxLoss() float64 {
+	if x != nil {
+		return x.MaxLoss
+	}
+	return 0
+}
+
+func (x *Position) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *Position) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Position) GetInitialSize() int32 {
+	if x != nil {
+		return x.InitialSize
+	}
+	return 0
+}
+
+func (x *Position) GetTargetsHit() int32 {
+	if x != nil {
+		return x.TargetsHit
+	}
+	return 0
+}
+
+func (x *Position) GetPeakUnitPnl() float64 {
+	if x != nil {
+		return x.PeakUnitPnl
+	}
+	return 0
+}
+
+func (x *Position) GetCloseReason() string {
+	if x != nil {
+		return x.CloseReason
+	}
+	return ""
+}
+
+func (x *Position) GetRolledFrom() string {
+	if x != nil {
+		return x.RolledFrom
+	}
+	return ""
+}
+
+func (x *Position) GetRolls() int32 {
+	if x != nil {
+		return x.Rolls
+	}
+	return 0
+}
+
+func (x *Position) GetExpiredLegs() []*PositionLeg {
+	if x != nil {
+		return x.ExpiredLegs
+	}
+	return nil
+}
+
+type RunLeg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol     string     `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	RootSymbol string     `protobuf:"bytes,2,opt,name=root_symbol,json=rootSymbol,proto3" json:"root_symbol,omitempty"`
+	OptionType OptionType `protobuf:"varint,3,opt,name=option_type,json=optionType,proto3,enum=bot.v1.OptionType" json:"option_type,omitempty"`
+	Strike     float64    `protobuf:"fixed64,4,opt,name=strike,proto3" json:"strike,omitempty"`
+	Expiration string     `protobuf:"bytes,5,opt,name=expiration,proto3" json:"expiration,omitempty"` // yyyy-mm-dd
+	// signed quantity of one unit
+	Quantity int32   `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Bid      float64 `protobuf:"fixed64,7,opt,name=bid,proto3" json:"bid,omitempty"`
+	Ask      float64 `protobuf:"fixed64,8,opt,name=ask,proto3" json:"ask,omitempty"`
+	Delta    float64 `protobuf:"fixed64,9,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (x *RunLeg) Reset() {
+	*x = RunLeg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunLeg) ProtoMessage() {}
+
+func (x *RunLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunLeg.ProtoReflect.Descriptor instead.
+func (*RunLeg) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RunLeg) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *RunLeg) GetRootSymbol() string {
+	if x != nil {
+		return x.RootSymbol
+	}
+	return ""
+}
+
+func (x *RunLeg) GetOptionType() OptionType {
+	if x != nil {
+		return x.OptionType
+	}
+	return OptionType_OPTION_TYPE_UNSPECIFIED
+}
+
+func (x *RunLeg) GetStrike() float64 {
+	if x != nil {
+		return x.Strike
+	}
+	return 0
+}
+
+func (x *RunLeg) GetExpiration() string {
+	if x != nil {
+		return x.Expiration
+	}
+	return ""
+}
+
+func (x *RunLeg) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RunLeg) GetBid() float64 {
+	if x != nil {
+		return x.Bid
+	}
+	return 0
+}
+
+func (x *RunLeg) GetAsk() float64 {
+	if x != nil {
+		return x.Ask
+	}
+	return 0
+}
+
+func (x *RunLeg) GetDelta() float64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+// Run is an audit record of one evaluation of a bot by the scheduler
+type Run struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BotId       string     `protobuf:"bytes,2,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	CreatedAt   int64      `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix timestamp in ms
+	Outcome     RunOutcome `protobuf:"varint,4,opt,name=outcome,proto3,enum=bot.v1.RunOutcome" json:"outcome,omitempty"`
+	EntryPassed bool       `protobuf:"varint,5,opt,name=entry_passed,json=entryPassed,proto3" json:"entry_passed,omitempty"`
+	// why the entry check didn't pass
+	EntryReason string `protobuf:"bytes,6,opt,name=entry_reason,json=entryReason,proto3" json:"entry_reason,omitempty"`
+	// the chains fetched to choose strikes, e.g. "SPXW 2024-01-19"
+	Chains []string  `protobuf:"bytes,7,rep,name=chains,proto3" json:"chains,omitempty"`
+	Legs   []*RunLeg `protobuf:"bytes,8,rep,name=legs,proto3" json:"legs,omitempty"`
+	// allocation size
+	Size int32 `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
+	// net mid price per share of one unit, positive for debit and negative for credit
+	Price float64 `protobuf:"fixed64,10,opt,name=price,proto3" json:"price,omitempty"`
+	// the max loss in dollars of all units, -1 for undefined risk
+	MaxLoss        float64  `protobuf:"fixed64,11,opt,name=max_loss,json=maxLoss,proto3" json:"max_loss,omitempty"`
+	RiskChecked    bool     `protobuf:"varint,12,opt,name=risk_checked,json=riskChecked,proto3" json:"risk_checked,omitempty"`
+	RiskViolations []string `protobuf:"bytes,13,rep,name=risk_violations,json=riskViolations,proto3" json:"risk_violations,omitempty"`
+	OrderIds       []string `protobuf:"bytes,14,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	PositionId     string   `protobuf:"bytes,15,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Error          string   `protobuf:"bytes,16,opt,name=error,proto3" json:"error,omitempty"`
+	// triggered by an operator rather than the entry schedule
+	Manual bool `protobuf:"varint,17,opt,name=manual,proto3" json:"manual,omitempty"`
+}
+
+func (x *Run) Reset() {
+	*x = Run{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Run) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Run) ProtoMessage() {}
+
+func (x *Run) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Run.ProtoReflect.Descriptor instead.
+func (*Run) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Run) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Run) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *Run) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Run) GetOutcome() RunOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return RunOutcome_RUN_OUTCOME_UNSPECIFIED
+}
+
+func (x *Run) GetEntryPassed() bool {
+	if x != nil {
+		return x.EntryPassed
+	}
+	return false
+}
+
+func (x *Run) GetEntryReason() string {
+	if x != nil {
+		return x.EntryReason
+	}
+	return ""
+}
+
+func (x *Run) GetChains() []string {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+func (x *Run) GetLegs() []*RunLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *Run) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Run) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Run) GetMaxLoss() float64 {
+	if x != nil {
+		return x.MaxLoss
+	}
+	return 0
+}
+
+func (x *Run) GetRiskChecked() bool {
+	if x != nil {
+		return x.RiskChecked
+	}
+	return false
+}
+
+func (x *Run) GetRiskViolations() []string {
+	if x != nil {
+		return x.RiskViolations
+	}
+	return nil
+}
+
+func (x *Run) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *Run) GetPositionId() string {
+	if x != nil {
+		return x.PositionId
+	}
+	return ""
+}
+
+func (x *Run) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Run) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+type TradingState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Halted    bool  `protobuf:"varint,1,opt,name=halted,proto3" json:"halted,omitempty"`
+	UpdatedAt int64 `protobuf:"varint,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // unix timestamp in ms
+	// who changed the state last
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *TradingState) Reset() {
+	*x = TradingState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradingState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradingState) ProtoMessage() {}
+
+func (x *TradingState) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradingState.ProtoReflect.Descriptor instead.
+func (*TradingState) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TradingState) GetHalted() bool {
+	if x != nil {
+		return x.Halted
+	}
+	return false
+}
+
+func (x *TradingState) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *TradingState) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *TradingState) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// TradingControlEvent is an audit record of a trading control call
+type TradingControlEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreatedAt         int64         `protobuf:"varint,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // unix timestamp in ms
+	Action            TradingAction `protobuf:"varint,2,opt,name=action,proto3,enum=bot.v1.TradingAction" json:"action,omitempty"`
+	Flatten           FlattenType   `protobuf:"varint,3,opt,name=flatten,proto3,enum=bot.v1.FlattenType" json:"flatten,omitempty"`
+	Operator          string        `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason            string        `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ClosedPositionIds []string      `protobuf:"bytes,6,rep,name=closed_position_ids,json=closedPositionIds,proto3" json:"closed_position_ids,omitempty"`
+	// errors of the positions failed to close
+	Errors []string `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *TradingControlEvent) Reset() {
+	*x = TradingControlEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradingControlEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradingControlEvent) ProtoMessage() {}
+
+func (x *TradingControlEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradingControlEvent.ProtoReflect.Descriptor instead.
+func (*TradingControlEvent) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TradingControlEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TradingControlEvent) GetAction() TradingAction {
+	if x != nil {
+		return x.Action
+	}
+	return TradingAction_TRADING_ACTION_UNSPECIFIED
+}
+
+func (x *TradingControlEvent) GetFlatten() FlattenType {
+	if x != nil {
+		return x.Flatten
+	}
+	return FlattenType_FLATTEN_TYPE_UNSPECIFIED
+}
+
+func (x *TradingControlEvent) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *TradingControlEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TradingControlEvent) GetClosedPositionIds() []string {
+	if x != nil {
+		return x.ClosedPositionIds
+	}
+	return nil
+}
+
+func (x *TradingControlEvent) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// FlattenProgress is the progress of the flatten of the last halt, which runs in the background
+type FlattenProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flatten            FlattenType `protobuf:"varint,1,opt,name=flatten,proto3,enum=bot.v1.FlattenType" json:"flatten,omitempty"`
+	StartedAt          int64       `protobuf:"varint,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`    // unix timestamp in ms
+	FinishedAt         int64       `protobuf:"varint,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"` // unix timestamp in ms, 0 while running
+	PendingPositionIds []string    `protobuf:"bytes,4,rep,name=pending_position_ids,json=pendingPositionIds,proto3" json:"pending_position_ids,omitempty"`
+	ClosedPositionIds  []string    `protobuf:"bytes,5,rep,name=closed_position_ids,json=closedPositionIds,proto3" json:"closed_position_ids,omitempty"`
+	// errors of the positions failed to close
+	Errors []string `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *FlattenProgress) Reset() {
+	*x = FlattenProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlattenProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlattenProgress) ProtoMessage() {}
+
+func (x *FlattenProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlattenProgress.ProtoReflect.Descriptor instead.
+func (*FlattenProgress) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FlattenProgress) GetFlatten() FlattenType {
+	if x != nil {
+		return x.Flatten
+	}
+	return FlattenType_FLATTEN_TYPE_UNSPECIFIED
+}
+
+func (x *FlattenProgress) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *FlattenProgress) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *FlattenProgress) GetPendingPositionIds() []string {
+	if x != nil {
+		return x.PendingPositionIds
+	}
+	return nil
+}
+
+func (x *FlattenProgress) GetClosedPositionIds() []string {
+	if x != nil {
+		return x.ClosedPositionIds
+	}
+	return nil
+}
+
+func (x *FlattenProgress) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Setting *Setting `protobuf:"bytes,2,opt,name=setting,proto3" json:"setting,omitempty"`
+	// the account to trade in
+	AccountId       string `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	EnableAutoOpen  bool   `protobuf:"varint,4,opt,name=enable_auto_open,json=enableAutoOpen,proto3" json:"enable_auto_open,omitempty"`
+	EnableAutoClose bool   `protobuf:"varint,5,opt,name=enable_auto_close,json=enableAutoClose,proto3" json:"enable_auto_close,omitempty"`
+	// the account supplying the market data, the global data source if empty
+	DataAccountId string `protobuf:"bytes,6,opt,name=data_account_id,json=dataAccountId,proto3" json:"data_account_id,omitempty"`
+}
+
+func (x *CreateRequest) Reset() {
+	*x = CreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRequest) ProtoMessage() {}
+
+func (x *CreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetSetting() *Setting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreateRequest) GetEnableAutoOpen() bool {
+	if x != nil {
+		return x.EnableAutoOpen
+	}
+	return false
+}
+
+func (x *CreateRequest) GetEnableAutoClose() bool {
+	if x != nil {
+		return x.EnableAutoClose
+	}
+	return false
+}
+
+func (x *CreateRequest) GetDataAccountId() string {
+	if x != nil {
+		return x.DataAccountId
+	}
+	return ""
+}
+
+type CreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Setting         *Setting `protobuf:"bytes,3,opt,name=setting,proto3" json:"setting,omitempty"`
+	AccountId       string   `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	EnableAutoOpen  bool     `protobuf:"varint,5,opt,name=enable_auto_open,json=enableAutoOpen,proto3" json:"enable_auto_open,omitempty"`
+	EnableAutoClose bool     `protobuf:"varint,6,opt,name=enable_auto_close,json=enableAutoClose,proto3" json:"enable_auto_close,omitempty"`
+	DataAccountId   string   `protobuf:"bytes,7,opt,name=data_account_id,json=dataAccountId,proto3" json:"data_account_id,omitempty"`
+}
+
+func (x *CreateResponse) Reset() {
+	*x = CreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResponse) ProtoMessage() {}
+
+func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
+func (*CreateResponse) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateResponse) GetSetting() *Setting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *CreateResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreateResponse) GetEnableAutoOpen() bool {
+	if x != nil {
+		return x.EnableAutoOpen
+	}
+	return false
+}
+
+func (x *CreateResponse) GetEnableAutoClose() bool {
+	if x != nil {
+		return x.EnableAutoClose
+	}
+	return false
+}
+
+func (x *CreateResponse) GetDataAccountId() string {
+	if x != nil {
+		return x.DataAccountId
+	}
+	return ""
+}
+
+type GetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Setting         *Setting `protobuf:"bytes,3,opt,name=setting,proto3" json:"setting,omitempty"`
+	AccountId       string   `protobuf:"bytes,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	EnableAutoOpen  bool     `protobuf:"varint,5,opt,name=enable_auto_open,json=enableAutoOpen,proto3" json:"enable_auto_open,omitempty"`
+	EnableAutoClose bool     `protobuf:"varint,6,opt,name=enable_auto_close,json=enableAutoClose,proto3" json:"enable_auto_close,omitempty"`
+	Status          *Status  `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	DataAccountId   string   `protobuf:"bytes,8,opt,name=data_account_id,json=dataAccountId,proto3" json:"data_account_id,omitempty"`
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetResponse) GetSetting() *Setting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *GetResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetResponse) GetEnableAutoOpen() bool {
+	if x != nil {
+		return x.EnableAutoOpen
+	}
+	return false
+}
+
+func (x *GetResponse) GetEnableAutoClose() bool {
+	if x != nil {
+		return x.EnableAutoClose
+	}
+	return false
+}
+
+func (x *GetResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetResponse) GetDataAccountId() string {
+	if x != nil {
+		return x.DataAccountId
+	}
+	return ""
+}
+
+type TradingControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action TradingAction `protobuf:"varint,1,opt,name=action,proto3,enum=bot.v1.TradingAction" json:"action,omitempty"`
+	// only for halt
+	Flatten FlattenType `protobuf:"varint,2,opt,name=flatten,proto3,enum=bot.v1.FlattenType" json:"flatten,omitempty"`
+	// who triggers it, the peer address if empty
+	Operator string `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *TradingControlRequest) Reset() {
+	*x = TradingControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradingControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradingControlRequest) ProtoMessage() {}
+
+func (x *TradingControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradingControlRequest.ProtoReflect.Descriptor instead.
+func (*TradingControlRequest) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TradingControlRequest) GetAction() TradingAction {
+	if x != nil {
+		return x.Action
+	}
+	return TradingAction_TRADING_ACTION_UNSPECIFIED
+}
+
+func (x *TradingControlRequest) GetFlatten() FlattenType {
+	if x != nil {
+		return x.Flatten
+	}
+	return FlattenType_FLATTEN_TYPE_UNSPECIFIED
+}
+
+func (x *TradingControlRequest) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *TradingControlRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TradingControlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *TradingState        `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Event *TradingControlEvent `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// the flatten started by the halt, poll GetTradingState for its progress
+	Flatten *FlattenProgress `protobuf:"bytes,3,opt,name=flatten,proto3" json:"flatten,omitempty"`
+}
+
+func (x *TradingControlResponse) Reset() {
+	*x = TradingControlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradingControlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradingControlResponse) ProtoMessage() {}
+
+func (x *TradingControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradingControlResponse.ProtoReflect.Descriptor instead.
+func (*TradingControlResponse) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TradingControlResponse) GetState() *TradingState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *TradingControlResponse) GetEvent() *TradingControlEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *TradingControlResponse) GetFlatten() *FlattenProgress {
+	if x != nil {
+		return x.Flatten
+	}
+	return nil
+}
+
+type GetTradingStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of the latest audit events to return
+	EventLimit int32 `protobuf:"varint,1,opt,name=event_limit,json=eventLimit,proto3" json:"event_limit,omitempty"`
+}
+
+func (x *GetTradingStateRequest) Reset() {
+	*x = GetTradingStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTradingStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradingStateRequest) ProtoMessage() {}
+
+func (x *GetTradingStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradingStateRequest.ProtoReflect.Descriptor instead.
+func (*GetTradingStateRequest) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTradingStateRequest) GetEventLimit() int32 {
+	if x != nil {
+		return x.EventLimit
+	}
+	return 0
+}
+
+type GetTradingStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *TradingState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// the latest first
+	Events []*TradingControlEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	// the flatten of the last halt since the server started
+	Flatten *FlattenProgress `protobuf:"bytes,3,opt,name=flatten,proto3" json:"flatten,omitempty"`
+}
+
+func (x *GetTradingStateResponse) Reset() {
+	*x = GetTradingStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTradingStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradingStateResponse) ProtoMessage() {}
+
+func (x *GetTradingStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradingStateResponse.ProtoReflect.Descriptor instead.
+func (*GetTradingStateResponse) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTradingStateResponse) GetState() *TradingState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *GetTradingStateResponse) GetEvents() []*TradingControlEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetTradingStateResponse) GetFlatten() *FlattenProgress {
+	if x != nil {
+		return x.Flatten
+	}
+	return nil
+}
+
+type ListRunsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// all bots if empty
+	BotId string `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	From  int64  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"` // unix timestamp in ms
+	To    int64  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`     // unix timestamp in ms, now if 0
+	// the latest runs if the limit is positive
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListRunsRequest) Reset() {
+	*x = ListRunsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunsRequest) ProtoMessage() {}
+
+func (x *ListRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListRunsRequest) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListRunsRequest) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *ListRunsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ListRunsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *ListRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRunsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// in the order of creation
+	Runs []*Run `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (x *ListRunsResponse) Reset() {
+	*x = ListRunsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunsResponse) ProtoMessage() {}
+
+func (x *ListRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListRunsResponse) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListRunsResponse) GetRuns() []*Run {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type OrderLeg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// buy_to_open, sell_to_open, buy_to_close or sell_to_close
+	Side     string `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	Quantity int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *OrderLeg) Reset() {
+	*x = OrderLeg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderLeg) ProtoMessage() {}
+
+func (x *OrderLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderLeg.ProtoReflect.Descriptor instead.
+func (*OrderLeg) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *OrderLeg) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OrderLeg) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *OrderLeg) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type OrderPreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Underlying string      `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	Legs       []*OrderLeg `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	// net limit price per share of one unit, positive for debit and negative for credit
+	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *OrderPreview) Reset() {
+	*x = OrderPreview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderPreview) ProtoMessage() {}
+
+func (x *OrderPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderPreview.ProtoReflect.Descriptor instead.
+func (*OrderPreview) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *OrderPreview) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *OrderPreview) GetLegs() []*OrderLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *OrderPreview) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type PayoffPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnderlyingPrice float64 `protobuf:"fixed64,1,opt,name=underlying_price,json=underlyingPrice,proto3" json:"underlying_price,omitempty"`
+	// profit and loss in dollars of all units at the expiration
+	Pnl float64 `protobuf:"fixed64,2,opt,name=pnl,proto3" json:"pnl,omitempty"`
+}
+
+func (x *PayoffPoint) Reset() {
+	*x = PayoffPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoffPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoffPoint) ProtoMessage() {}
+
+func (x *PayoffPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoffPoint.ProtoReflect.Descriptor instead.
+func (*PayoffPoint) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PayoffPoint) GetUnderlyingPrice() float64 {
+	if x != nil {
+		return x.UnderlyingPrice
+	}
+	return 0
+}
+
+func (x *PayoffPoint) GetPnl() float64 {
+	if x != nil {
+		return x.Pnl
+	}
+	return 0
+}
+
+type PreviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// preview a saved bot
+	BotId string `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+	// or a setting in an account before creating the bot
+	Setting   *Setting `protobuf:"bytes,2,opt,name=setting,proto3" json:"setting,omitempty"`
+	AccountId string   `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// the global data source if empty
+	DataAccountId string `protobuf:"bytes,4,opt,name=data_account_id,json=dataAccountId,proto3" json:"data_account_id,omitempty"`
+}
+
+func (x *PreviewRequest) Reset() {
+	*x = PreviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRequest) ProtoMessage() {}
+
+func (x *PreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRequest) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PreviewRequest) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+func (x *PreviewRequest) GetSetting() *Setting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *PreviewRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PreviewRequest) GetDataAccountId() string {
+	if x != nil {
+		return x.DataAccountId
+	}
+	return ""
+}
+
+type PreviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the chains considered, e.g. "SPXW 2024-01-19"
+	Chains []string  `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+	Legs   []*RunLeg `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	Size   int32     `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// net mid price per share of one unit, positive for debit and negative for credit
+	Price float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	// the max loss in dollars of all units, -1 for undefined risk
+	MaxLoss float64       `protobuf:"fixed64,5,opt,name=max_loss,json=maxLoss,proto3" json:"max_loss,omitempty"`
+	Order   *OrderPreview `protobuf:"bytes,6,opt,name=order,proto3" json:"order,omitempty"`
+	// why the order would be blocked, empty if it would pass
+	RiskViolations []string `protobuf:"bytes,7,rep,name=risk_violations,json=riskViolations,proto3" json:"risk_violations,omitempty"`
+	// at the first expiration, ascending by the underlying price
+	Payoff []*PayoffPoint `protobuf:"bytes,8,rep,name=payoff,proto3" json:"payoff,omitempty"`
+}
+
+func (x *PreviewResponse) Reset() {
+	*x = PreviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewResponse) ProtoMessage() {}
+
+func (x *PreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewResponse.ProtoReflect.Descriptor instead.
+func (*PreviewResponse) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PreviewResponse) GetChains() []string {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+func (x *PreviewResponse) GetLegs() []*RunLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *PreviewResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PreviewResponse) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PreviewResponse) GetMaxLoss() float64 {
+	if x != nil {
+		return x.MaxLoss
+	}
+	return 0
+}
+
+func (x *PreviewResponse) GetOrder() *OrderPreview {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *PreviewResponse) GetRiskViolations() []string {
+	if x != nil {
+		return x.RiskViolations
+	}
+	return nil
+}
+
+func (x *PreviewResponse) GetPayoff() []*PayoffPoint {
+	if x != nil {
+		return x.Payoff
+	}
+	return nil
+}
+
+type OpenNowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BotId string `protobuf:"bytes,1,opt,name=bot_id,json=botId,proto3" json:"bot_id,omitempty"`
+}
+
+func (x *OpenNowRequest) Reset() {
+	*x = OpenNowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenNowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenNowRequest) ProtoMessage() {}
+
+func (x *OpenNowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenNowRequest.ProtoReflect.Descriptor instead.
+func (*OpenNowRequest) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *OpenNowRequest) GetBotId() string {
+	if x != nil {
+		return x.BotId
+	}
+	return ""
+}
+
+type OpenNowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Run      *Run      `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	Position *Position `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *OpenNowResponse) Reset() {
+	*x = OpenNowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenNowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenNowResponse) ProtoMessage() {}
+
+func (x *OpenNowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenNowResponse.ProtoReflect.Descriptor instead.
+func (*OpenNowResponse) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *OpenNowResponse) GetRun() *Run {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *OpenNowResponse) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type ClosePositionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PositionId string         `protobuf:"bytes,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Style      ExecutionStyle `protobuf:"varint,2,opt,name=style,proto3,enum=bot.v1.ExecutionStyle" json:"style,omitempty"`
+	// number of units to close, all if 0
+	Size int32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ClosePositionRequest) Reset() {
+	*x = ClosePositionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePositionRequest) ProtoMessage() {}
+
+func (x *ClosePositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePositionRequest.ProtoReflect.Descriptor instead.
+func (*ClosePositionRequest) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ClosePositionRequest) GetPositionId() string {
+	if x != nil {
+		return x.PositionId
+	}
+	return ""
+}
+
+func (x *ClosePositionRequest) GetStyle() ExecutionStyle {
+	if x != nil {
+		return x.Style
+	}
+	return ExecutionStyle_EXECUTION_STYLE_UNSPECIFIED
+}
+
+func (x *ClosePositionRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ClosePositionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *ClosePositionResponse) Reset() {
+	*x = ClosePositionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bot_v1_bot_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePositionResponse) ProtoMessage() {}
+
+func (x *ClosePositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bot_v1_bot_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePositionResponse.ProtoReflect.Descriptor instead.
+func (*ClosePositionResponse) Descriptor() ([]byte, []int) {
+	return file_bot_v1_bot_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ClosePositionResponse) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+var File_bot_v1_bot_proto protoreflect.FileDescriptor
+
+var file_bot_v1_bot_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x62, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x74, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x06, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x22, 0x31, 0x0a, 0x0b, 0x44, 0x6f,
 	0x75, 0x62, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d,
 	0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x2e, 0x0a,
 	0x08, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x9b, 0x02,
+	0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0xcc, 0x02,
 	0x0a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x6f,
 	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x62, 0x6f, 0x74, 0x2e,
 	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x43, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x72,
@@ -1306,132 +4349,622 @@ var file_bot_v1_bot_proto_rawDesc = []byte{
 	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63,
 	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
 	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x73,
-	0x74, 0x72, 0x69, 0x6b, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6b, 0x0a, 0x03, 0x44,
-	0x54, 0x45, 0x12, 0x23, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x74, 0x65, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x64, 0x74, 0x65,
-	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62,
-	0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08,
-	0x64, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x03, 0x4c, 0x65, 0x67,
-	0x12, 0x26, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0b, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
-	0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x72,
-	0x69, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6b,
-	0x65, 0x12, 0x1d, 0x0a, 0x03, 0x64, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x54, 0x45, 0x52, 0x03, 0x64, 0x74, 0x65,
-	0x22, 0x7d, 0x0a, 0x0a, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
-	0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x69, 0x73, 0x6b,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x69, 0x73, 0x6b, 0x22,
-	0x95, 0x01, 0x0a, 0x0f, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x43, 0x68, 0x6f, 0x6f,
-	0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x64, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74,
-	0x75, 0x65, 0x73, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x75,
-	0x65, 0x73, 0x64, 0x61, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x65, 0x64, 0x6e, 0x65, 0x73, 0x64,
-	0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x65, 0x64, 0x6e, 0x65, 0x73,
-	0x64, 0x61, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x75, 0x72, 0x73, 0x64, 0x61, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x68, 0x75, 0x72, 0x73, 0x64, 0x61, 0x79, 0x12,
-	0x16, 0x0a, 0x06, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x22, 0x32, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68,
-	0x6f, 0x75, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22, 0x5e, 0x0a, 0x05, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x43, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x72, 0x52,
-	0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x04, 0x45,
-	0x78, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x64, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x5f,
-	0x77, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x57,
-	0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x22,
-	0xc5, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x75,
-	0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x04, 0x6c,
-	0x65, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x62, 0x6f, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x0a,
-	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x23, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x69,
-	0x74, 0x52, 0x04, 0x65, 0x78, 0x69, 0x74, 0x22, 0x4e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x52, 0x09, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x22, 0xe0, 0x01, 0x0a,
+	0x09, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6d, 0x61, 0x78, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78,
+	0x5f, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x62,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x42, 0x69, 0x64,
+	0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69, 0x6e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x6d, 0x69, 0x6e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x67, 0x65, 0x22,
+	0x6b, 0x0a, 0x03, 0x44, 0x54, 0x45, 0x12, 0x23, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x74, 0x65, 0x12, 0x2d, 0x0a,
+	0x09, 0x64, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x08, 0x64, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xc5, 0x01, 0x0a,
+	0x03, 0x4c, 0x65, 0x67, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0b,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a,
+	0x06, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x52, 0x06, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x12, 0x1d, 0x0a, 0x03, 0x64, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x54, 0x45, 0x52,
+	0x03, 0x64, 0x74, 0x65, 0x22, 0x7d, 0x0a, 0x0a, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x69, 0x73, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52,
+	0x69, 0x73, 0x6b, 0x22, 0x95, 0x01, 0x0a, 0x0f, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73,
+	0x43, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x6e, 0x64, 0x61,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x6f, 0x6e, 0x64, 0x61, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x75, 0x65, 0x73, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x74, 0x75, 0x65, 0x73, 0x64, 0x61, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x65, 0x64,
+	0x6e, 0x65, 0x73, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x65,
+	0x64, 0x6e, 0x65, 0x73, 0x64, 0x61, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x75, 0x72, 0x73,
+	0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x68, 0x75, 0x72, 0x73,
+	0x64, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x22, 0x32, 0x0a, 0x04, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x22,
+	0x49, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x03,
+	0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d,
+	0x69, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0x6f, 0x0a, 0x08, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65,
+	0x76, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x90, 0x03, 0x0a, 0x05,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x33, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x43, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x72,
+	0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x05,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x12, 0x2c, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x23,
+	0x0a, 0x03, 0x76, 0x69, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03,
+	0x76, 0x69, 0x78, 0x12, 0x2a, 0x0a, 0x07, 0x69, 0x76, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x69, 0x76, 0x52, 0x61, 0x6e, 0x6b, 0x12,
+	0x36, 0x0a, 0x0d, 0x69, 0x76, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x76, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x64, 0x61, 0x79, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x64, 0x61, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x03, 0x67, 0x61, 0x70,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x67, 0x61, 0x70, 0x22, 0x4b,
+	0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x0c, 0x54,
+	0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x72, 0x61, 0x69,
+	0x6c, 0x22, 0xba, 0x02, 0x0a, 0x04, 0x45, 0x78, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x57, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f,
+	0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x74,
+	0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x3b,
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x0d, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x69,
+	0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x6d, 0x61, 0x78, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x96,
+	0x02, 0x0a, 0x0a, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a,
+	0x08, 0x72, 0x6f, 0x6c, 0x6c, 0x5f, 0x64, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x54, 0x45, 0x52, 0x07, 0x72, 0x6f,
+	0x6c, 0x6c, 0x44, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x5f,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x52, 0x6f, 0x6c, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x30, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12,
+	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x69, 0x6e, 0x44, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xd1, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e,
+	0x67, 0x12, 0x1f, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65,
+	0x67, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x04, 0x65,
+	0x78, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6f, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x52, 0x04, 0x65, 0x78, 0x69, 0x74, 0x12, 0x2c, 0x0a,
+	0x12, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4f, 0x70,
+	0x65, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x61,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x88, 0x02, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x76, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72,
+	0x69, 0x73, 0x6b, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x69, 0x73, 0x6b, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xc5, 0x02, 0x0a, 0x0b, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x65, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x33, 0x0a,
+	0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x22, 0xac, 0x05, 0x0a,
+	0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x6f, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x74, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x12,
+	0x27, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x65, 0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f,
+	0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x73,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x70, 0x6e,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x64, 0x50, 0x6e, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x5f,
+	0x68, 0x69, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x48, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x65,
+	0x61, 0x6b, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x6e, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72, 0x6f,
+	0x6c, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x6c,
+	0x65, 0x67, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x67, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x4c, 0x65, 0x67, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x06,
+	0x52, 0x75, 0x6e, 0x4c, 0x65, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x33, 0x0a, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73,
+	0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x22, 0xf8, 0x03, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x6f,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x74, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x2c, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x61, 0x73, 0x73, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x04,
+	0x6c, 0x65, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x61,
+	0x78, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x72, 0x69, 0x73,
+	0x6b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x69, 0x73, 0x6b,
+	0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x69, 0x73, 0x6b, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x22, 0x79, 0x0a,
+	0x0c, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68,
+	0x61, 0x6c, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x8e, 0x02, 0x0a, 0x13, 0x54, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x2d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
+	0x0a, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xfa, 0x01, 0x0a, 0x0f, 0x46, 0x6c,
+	0x61, 0x74, 0x74, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a,
+	0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x14,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07,
 	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
 	0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07,
-	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x5f, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a,
-	0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
-	0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x1c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x6f, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x2a, 0x43, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
-	0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x43, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10, 0x02, 0x2a, 0x54, 0x0a, 0x0a, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x50, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x50,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x54, 0x10, 0x02, 0x2a,
-	0x69, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x41, 0x54, 0x43,
-	0x48, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x01,
-	0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4e, 0x45, 0x41, 0x52, 0x45, 0x53,
-	0x54, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x5f,
-	0x4c, 0x45, 0x41, 0x53, 0x54, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x43, 0x48,
-	0x5f, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x53, 0x54, 0x10, 0x04, 0x2a, 0x63, 0x0a, 0x0d, 0x53, 0x74,
-	0x72, 0x69, 0x6b, 0x65, 0x43, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x53,
-	0x54, 0x52, 0x49, 0x4b, 0x45, 0x5f, 0x43, 0x48, 0x4f, 0x4f, 0x53, 0x45, 0x52, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53,
-	0x54, 0x52, 0x49, 0x4b, 0x45, 0x5f, 0x43, 0x48, 0x4f, 0x4f, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x45,
-	0x4c, 0x54, 0x41, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x49, 0x4b, 0x45, 0x5f,
-	0x43, 0x48, 0x4f, 0x4f, 0x53, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x02, 0x2a,
-	0x56, 0x0a, 0x09, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x15,
-	0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4c, 0x4c, 0x4f, 0x43,
-	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x10, 0x01, 0x12,
-	0x16, 0x0a, 0x12, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4d, 0x41, 0x58,
-	0x5f, 0x52, 0x49, 0x53, 0x4b, 0x10, 0x02, 0x32, 0x79, 0x0a, 0x0a, 0x42, 0x6f, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
-	0x15, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x30, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x62, 0x6f,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x70, 0x70, 0x61, 0x61, 0x6e, 0x6e, 0x67, 0x67, 0x67, 0x67, 0x2f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x2d, 0x62, 0x6f, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x62, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6f, 0x74, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x4f, 0x70, 0x65, 0x6e,
+	0x12, 0x2a, 0x0a, 0x11, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0xfc, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62,
+	0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x4f, 0x70, 0x65, 0x6e, 0x12,
+	0x2a, 0x0a, 0x11, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0xa1, 0x02, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x6f,
+	0x70, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x75, 0x74, 0x6f, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
+	0x0a, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x66,
+	0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62,
+	0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x22, 0x39,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x22, 0x62, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x62, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f,
+	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x33, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x72, 0x75,
+	0x6e, 0x73, 0x22, 0x52, 0x0a, 0x08, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4c, 0x65, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x6a, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c,
+	0x79, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65,
+	0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x22, 0x4a, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x70, 0x6e, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x6e, 0x6c, 0x22, 0x99,
+	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x62, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x62, 0x6f, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x61, 0x74,
+	0x61, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x94, 0x02, 0x0a, 0x0f, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75,
+	0x6e, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x73, 0x73, 0x12,
+	0x2a, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72,
+	0x69, 0x73, 0x6b, 0x5f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x69, 0x73, 0x6b, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x79, 0x6f, 0x66, 0x66, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x66,
+	0x66, 0x22, 0x27, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x6f, 0x74, 0x49, 0x64, 0x22, 0x5e, 0x0a, 0x0f, 0x4f, 0x70,
+	0x65, 0x6e, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
+	0x03, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x62, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x2c, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x14, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x52, 0x05, 0x73, 0x74, 0x79, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x45, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x43, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x10,
+	0x02, 0x2a, 0x54, 0x0a, 0x0a, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1b, 0x0a, 0x17, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
+	0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4c, 0x4c,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x50, 0x55, 0x54, 0x10, 0x02, 0x2a, 0x69, 0x0a, 0x05, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x15, 0x0a, 0x11, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x4e, 0x45, 0x41, 0x52, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x5f, 0x4c, 0x45, 0x41, 0x53, 0x54, 0x10, 0x03, 0x12,
+	0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x53, 0x54,
+	0x10, 0x04, 0x2a, 0x63, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x43, 0x68, 0x6f, 0x6f,
+	0x73, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x54, 0x52, 0x49, 0x4b, 0x45, 0x5f, 0x43, 0x48,
+	0x4f, 0x4f, 0x53, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x49, 0x4b, 0x45, 0x5f, 0x43, 0x48,
+	0x4f, 0x4f, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x4c, 0x54, 0x41, 0x10, 0x01, 0x12, 0x18, 0x0a,
+	0x14, 0x53, 0x54, 0x52, 0x49, 0x4b, 0x45, 0x5f, 0x43, 0x48, 0x4f, 0x4f, 0x53, 0x45, 0x52, 0x5f,
+	0x50, 0x52, 0x49, 0x43, 0x45, 0x10, 0x02, 0x2a, 0x56, 0x0a, 0x09, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x4f,
+	0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x4e,
+	0x53, 0x54, 0x41, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4c, 0x4c, 0x4f, 0x43,
+	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x49, 0x53, 0x4b, 0x10, 0x02, 0x2a,
+	0x41, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1b, 0x0a,
+	0x17, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x41,
+	0x52, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x44, 0x4f, 0x4c, 0x4c, 0x41, 0x52,
+	0x10, 0x01, 0x2a, 0x67, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x49, 0x52, 0x59, 0x5f, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58, 0x50, 0x49, 0x52, 0x59, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x49, 0x54, 0x4d, 0x10, 0x01, 0x12, 0x1b,
+	0x0a, 0x17, 0x45, 0x58, 0x50, 0x49, 0x52, 0x59, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x2a, 0x67, 0x0a, 0x0e, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a,
+	0x1b, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4f, 0x53, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x44, 0x10, 0x02, 0x2a, 0x8a, 0x01, 0x0a, 0x0a, 0x52, 0x75, 0x6e, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x55, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f,
+	0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x52, 0x55, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f,
+	0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x55, 0x4e,
+	0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x55, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45,
+	0x5f, 0x56, 0x45, 0x54, 0x4f, 0x45, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x55, 0x4e,
+	0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x45, 0x44, 0x10,
+	0x04, 0x2a, 0x68, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x79, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x59, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x59, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54, 0x10, 0x01,
+	0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x59, 0x4c, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x02, 0x2a, 0x7f, 0x0a, 0x0d, 0x54,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a,
+	0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48,
+	0x41, 0x4c, 0x54, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4d, 0x45, 0x10, 0x02,
+	0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x46, 0x4c, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x10, 0x03, 0x2a, 0x5c, 0x0a, 0x0b,
+	0x46, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x46,
+	0x4c, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x4c, 0x41,
+	0x54, 0x54, 0x45, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x45, 0x54,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4c, 0x41, 0x54, 0x54, 0x45, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x02, 0x32, 0xaf, 0x04, 0x0a, 0x0a, 0x42,
+	0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x30, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x12, 0x2e, 0x62, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x62,
+	0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62,
+	0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x62, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3c, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x16, 0x2e, 0x62, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c,
+	0x0a, 0x07, 0x4f, 0x70, 0x65, 0x6e, 0x4e, 0x6f, 0x77, 0x12, 0x16, 0x2e, 0x62, 0x6f, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4e, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4e,
+	0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e,
+	0x62, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x70, 0x61, 0x61, 0x6e,
+	0x6e, 0x67, 0x67, 0x67, 0x67, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x62, 0x6f, 0x74,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x62, 0x6f, 0x74, 0x2f, 0x76,
+	0x31, 0x3b, 0x62, 0x6f, 0x74, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1446,61 +4979,156 @@ func file_bot_v1_bot_proto_rawDescGZIP() []byte {
 	return file_bot_v1_bot_proto_rawDescData
 }
 
-var file_bot_v1_bot_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_bot_v1_bot_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_bot_v1_bot_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_bot_v1_bot_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_bot_v1_bot_proto_goTypes = []interface{}{
-	(Action)(0),             // 0: bot.v1.Action
-	(OptionType)(0),         // 1: bot.v1.OptionType
-	(Match)(0),              // 2: bot.v1.Match
-	(StrikeChooser)(0),      // 3: bot.v1.StrikeChooser
-	(Allocator)(0),          // 4: bot.v1.Allocator
-	(*DoubleRange)(nil),     // 5: bot.v1.DoubleRange
-	(*IntRange)(nil),        // 6: bot.v1.IntRange
-	(*Strike)(nil),          // 7: bot.v1.Strike
-	(*DTE)(nil),             // 8: bot.v1.DTE
-	(*Leg)(nil),             // 9: bot.v1.Leg
-	(*Allocation)(nil),      // 10: bot.v1.Allocation
-	(*WeekdaysChooser)(nil), // 11: bot.v1.WeekdaysChooser
-	(*Time)(nil),            // 12: bot.v1.Time
-	(*Entry)(nil),           // 13: bot.v1.Entry
-	(*Exit)(nil),            // 14: bot.v1.Exit
-	(*Setting)(nil),         // 15: bot.v1.Setting
-	(*CreateRequest)(nil),   // 16: bot.v1.CreateRequest
-	(*CreateResponse)(nil),  // 17: bot.v1.CreateResponse
-	(*GetRequest)(nil),      // 18: bot.v1.GetRequest
-	(*GetResponse)(nil),     // 19: bot.v1.GetResponse
+	(Action)(0),                     // 0: bot.v1.Action
+	(OptionType)(0),                 // 1: bot.v1.OptionType
+	(Match)(0),                      // 2: bot.v1.Match
+	(StrikeChooser)(0),              // 3: bot.v1.StrikeChooser
+	(Allocator)(0),                  // 4: bot.v1.Allocator
+	(TargetUnit)(0),                 // 5: bot.v1.TargetUnit
+	(ExpiryPolicy)(0),               // 6: bot.v1.ExpiryPolicy
+	(PositionStatus)(0),             // 7: bot.v1.PositionStatus
+	(RunOutcome)(0),                 // 8: bot.v1.RunOutcome
+	(ExecutionStyle)(0),             // 9: bot.v1.ExecutionStyle
+	(TradingAction)(0),              // 10: bot.v1.TradingAction
+	(FlattenType)(0),                // 11: bot.v1.FlattenType
+	(*DoubleRange)(nil),             // 12: bot.v1.DoubleRange
+	(*IntRange)(nil),                // 13: bot.v1.IntRange
+	(*Strike)(nil),                  // 14: bot.v1.Strike
+	(*Liquidity)(nil),               // 15: bot.v1.Liquidity
+	(*DTE)(nil),                     // 16: bot.v1.DTE
+	(*Leg)(nil),                     // 17: bot.v1.Leg
+	(*Allocation)(nil),              // 18: bot.v1.Allocation
+	(*WeekdaysChooser)(nil),         // 19: bot.v1.WeekdaysChooser
+	(*Time)(nil),                    // 20: bot.v1.Time
+	(*Condition)(nil),               // 21: bot.v1.Condition
+	(*Interval)(nil),                // 22: bot.v1.Interval
+	(*Entry)(nil),                   // 23: bot.v1.Entry
+	(*ProfitTarget)(nil),            // 24: bot.v1.ProfitTarget
+	(*TrailingStop)(nil),            // 25: bot.v1.TrailingStop
+	(*Exit)(nil),                    // 26: bot.v1.Exit
+	(*Adjustment)(nil),              // 27: bot.v1.Adjustment
+	(*Expiry)(nil),                  // 28: bot.v1.Expiry
+	(*Setting)(nil),                 // 29: bot.v1.Setting
+	(*Status)(nil),                  // 30: bot.v1.Status
+	(*PositionLeg)(nil),             // 31: bot.v1.PositionLeg
+	(*Position)(nil),                // 32: bot.v1.Position
+	(*RunLeg)(nil),                  // 33: bot.v1.RunLeg
+	(*Run)(nil),                     // 34: bot.v1.Run
+	(*TradingState)(nil),            // 35: bot.v1.TradingState
+	(*TradingControlEvent)(nil),     // 36: bot.v1.TradingControlEvent
+	(*FlattenProgress)(nil),         // 37: bot.v1.FlattenProgress
+	(*CreateRequest)(nil),           // 38: bot.v1.CreateRequest
+	(*CreateResponse)(nil),          // 39: bot.v1.CreateResponse
+	(*GetRequest)(nil),              // 40: bot.v1.GetRequest
+	(*GetResponse)(nil),             // 41: bot.v1.GetResponse
+	(*TradingControlRequest)(nil),   // 42: bot.v1.TradingControlRequest
+	(*TradingControlResponse)(nil),  // 43: bot.v1.TradingControlResponse
+	(*GetTradingStateRequest)(nil),  // 44: bot.v1.GetTradingStateRequest
+	(*GetTradingStateResponse)(nil), // 45: bot.v1.GetTradingStateResponse
+	(*ListRunsRequest)(nil),         // 46: bot.v1.ListRunsRequest
+	(*ListRunsResponse)(nil),        // 47: bot.v1.ListRunsResponse
+	(*OrderLeg)(nil),                // 48: bot.v1.OrderLeg
+	(*OrderPreview)(nil),            // 49: bot.v1.OrderPreview
+	(*PayoffPoint)(nil),             // 50: bot.v1.PayoffPoint
+	(*PreviewRequest)(nil),          // 51: bot.v1.PreviewRequest
+	(*PreviewResponse)(nil),         // 52: bot.v1.PreviewResponse
+	(*OpenNowRequest)(nil),          // 53: bot.v1.OpenNowRequest
+	(*OpenNowResponse)(nil),         // 54: bot.v1.OpenNowResponse
+	(*ClosePositionRequest)(nil),    // 55: bot.v1.ClosePositionRequest
+	(*ClosePositionResponse)(nil),   // 56: bot.v1.ClosePositionResponse
 }
 var file_bot_v1_bot_proto_depIdxs = []int32{
 	3,  // 0: bot.v1.Strike.chooser:type_name -> bot.v1.StrikeChooser
 	2,  // 1: bot.v1.Strike.match:type_name -> bot.v1.Match
-	5,  // 2: bot.v1.Strike.delta_range:type_name -> bot.v1.DoubleRange
-	5,  // 3: bot.v1.Strike.price_range:type_name -> bot.v1.DoubleRange
-	2,  // 4: bot.v1.DTE.match:type_name -> bot.v1.Match
-	6,  // 5: bot.v1.DTE.dte_range:type_name -> bot.v1.IntRange
-	0,  // 6: bot.v1.Leg.action:type_name -> bot.v1.Action
-	1,  // 7: bot.v1.Leg.option_type:type_name -> bot.v1.OptionType
-	7,  // 8: bot.v1.Leg.strike:type_name -> bot.v1.Strike
-	8,  // 9: bot.v1.Leg.dte:type_name -> bot.v1.DTE
-	4,  // 10: bot.v1.Allocation.allocator:type_name -> bot.v1.Allocator
-	11, // 11: bot.v1.Entry.weekdays:type_name -> bot.v1.WeekdaysChooser
-	12, // 12: bot.v1.Entry.time:type_name -> bot.v1.Time
-	12, // 13: bot.v1.Exit.time:type_name -> bot.v1.Time
-	9,  // 14: bot.v1.Setting.legs:type_name -> bot.v1.Leg
-	10, // 15: bot.v1.Setting.allocation:type_name -> bot.v1.Allocation
-	13, // 16: bot.v1.Setting.entry:type_name -> bot.v1.Entry
-	14, // 17: bot.v1.Setting.exit:type_name -> bot.v1.Exit
-	15, // 18: bot.v1.CreateRequest.setting:type_name -> bot.v1.Setting
-	15, // 19: bot.v1.CreateResponse.setting:type_name -> bot.v1.Setting
-	15, // 20: bot.v1.GetResponse.setting:type_name -> bot.v1.Setting
-	16, // 21: bot.v1.BotService.Create:input_type -> bot.v1.CreateRequest
-	18, // 22: bot.v1.BotService.Get:input_type -> bot.v1.GetRequest
-	17, // 23: bot.v1.BotService.Create:output_type -> bot.v1.CreateResponse
-	19, // 24: bot.v1.BotService.Get:output_type -> bot.v1.GetResponse
-	23, // [23:25] is the sub-list for method output_type
-	21, // [21:23] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	12, // 2: bot.v1.Strike.delta_range:type_name -> bot.v1.DoubleRange
+	12, // 3: bot.v1.Strike.price_range:type_name -> bot.v1.DoubleRange
+	15, // 4: bot.v1.Strike.liquidity:type_name -> bot.v1.Liquidity
+	2,  // 5: bot.v1.DTE.match:type_name -> bot.v1.Match
+	13, // 6: bot.v1.DTE.dte_range:type_name -> bot.v1.IntRange
+	0,  // 7: bot.v1.Leg.action:type_name -> bot.v1.Action
+	1,  // 8: bot.v1.Leg.option_type:type_name -> bot.v1.OptionType
+	14, // 9: bot.v1.Leg.strike:type_name -> bot.v1.Strike
+	16, // 10: bot.v1.Leg.dte:type_name -> bot.v1.DTE
+	4,  // 11: bot.v1.Allocation.allocator:type_name -> bot.v1.Allocator
+	20, // 12: bot.v1.Interval.from:type_name -> bot.v1.Time
+	20, // 13: bot.v1.Interval.to:type_name -> bot.v1.Time
+	19, // 14: bot.v1.Entry.weekdays:type_name -> bot.v1.WeekdaysChooser
+	20, // 15: bot.v1.Entry.time:type_name -> bot.v1.Time
+	20, // 16: bot.v1.Entry.times:type_name -> bot.v1.Time
+	22, // 17: bot.v1.Entry.interval:type_name -> bot.v1.Interval
+	21, // 18: bot.v1.Entry.vix:type_name -> bot.v1.Condition
+	21, // 19: bot.v1.Entry.iv_rank:type_name -> bot.v1.Condition
+	21, // 20: bot.v1.Entry.iv_percentile:type_name -> bot.v1.Condition
+	21, // 21: bot.v1.Entry.day_change:type_name -> bot.v1.Condition
+	21, // 22: bot.v1.Entry.gap:type_name -> bot.v1.Condition
+	20, // 23: bot.v1.Exit.time:type_name -> bot.v1.Time
+	5,  // 24: bot.v1.Exit.unit:type_name -> bot.v1.TargetUnit
+	24, // 25: bot.v1.Exit.profit_targets:type_name -> bot.v1.ProfitTarget
+	25, // 26: bot.v1.Exit.trailing_stop:type_name -> bot.v1.TrailingStop
+	16, // 27: bot.v1.Adjustment.roll_dte:type_name -> bot.v1.DTE
+	6,  // 28: bot.v1.Expiry.policy:type_name -> bot.v1.ExpiryPolicy
+	17, // 29: bot.v1.Setting.legs:type_name -> bot.v1.Leg
+	18, // 30: bot.v1.Setting.allocation:type_name -> bot.v1.Allocation
+	23, // 31: bot.v1.Setting.entry:type_name -> bot.v1.Entry
+	26, // 32: bot.v1.Setting.exit:type_name -> bot.v1.Exit
+	27, // 33: bot.v1.Setting.adjustments:type_name -> bot.v1.Adjustment
+	28, // 34: bot.v1.Setting.expiry:type_name -> bot.v1.Expiry
+	1,  // 35: bot.v1.PositionLeg.option_type:type_name -> bot.v1.OptionType
+	31, // 36: bot.v1.Position.legs:type_name -> bot.v1.PositionLeg
+	7,  // 37: bot.v1.Position.status:type_name -> bot.v1.PositionStatus
+	31, // 38: bot.v1.Position.expired_legs:type_name -> bot.v1.PositionLeg
+	1,  // 39: bot.v1.RunLeg.option_type:type_name -> bot.v1.OptionType
+	8,  // 40: bot.v1.Run.outcome:type_name -> bot.v1.RunOutcome
+	33, // 41: bot.v1.Run.legs:type_name -> bot.v1.RunLeg
+	10, // 42: bot.v1.TradingControlEvent.action:type_name -> bot.v1.TradingAction
+	11, // 43: bot.v1.TradingControlEvent.flatten:type_name -> bot.v1.FlattenType
+	11, // 44: bot.v1.FlattenProgress.flatten:type_name -> bot.v1.FlattenType
+	29, // 45: bot.v1.CreateRequest.setting:type_name -> bot.v1.Setting
+	29, // 46: bot.v1.CreateResponse.setting:type_name -> bot.v1.Setting
+	29, // 47: bot.v1.GetResponse.setting:type_name -> bot.v1.Setting
+	30, // 48: bot.v1.GetResponse.status:type_name -> bot.v1.Status
+	10, // 49: bot.v1.TradingControlRequest.action:type_name -> bot.v1.TradingAction
+	11, // 50: bot.v1.TradingControlRequest.flatten:type_name -> bot.v1.FlattenType
+	35, // 51: bot.v1.TradingControlResponse.state:type_name -> bot.v1.TradingState
+	36, // 52: bot.v1.TradingControlResponse.event:type_name -> bot.v1.TradingControlEvent
+	37, // 53: bot.v1.TradingControlResponse.flatten:type_name -> bot.v1.FlattenProgress
+	35, // 54: bot.v1.GetTradingStateResponse.state:type_name -> bot.v1.TradingState
+	36, // 55: bot.v1.GetTradingStateResponse.events:type_name -> bot.v1.TradingControlEvent
+	37, // 56: bot.v1.GetTradingStateResponse.flatten:type_name -> bot.v1.FlattenProgress
+	34, // 57: bot.v1.ListRunsResponse.runs:type_name -> bot.v1.Run
+	48, // 58: bot.v1.OrderPreview.legs:type_name -> bot.v1.OrderLeg
+	29, // 59: bot.v1.PreviewRequest.setting:type_name -> bot.v1.Setting
+	33, // 60: bot.v1.PreviewResponse.legs:type_name -> bot.v1.RunLeg
+	49, // 61: bot.v1.PreviewResponse.order:type_name -> bot.v1.OrderPreview
+	50, // 62: bot.v1.PreviewResponse.payoff:type_name -> bot.v1.PayoffPoint
+	34, // 63: bot.v1.OpenNowResponse.run:type_name -> bot.v1.Run
+	32, // 64: bot.v1.OpenNowResponse.position:type_name -> bot.v1.Position
+	9,  // 65: bot.v1.ClosePositionRequest.style:type_name -> bot.v1.ExecutionStyle
+	32, // 66: bot.v1.ClosePositionResponse.position:type_name -> bot.v1.Position
+	38, // 67: bot.v1.BotService.Create:input_type -> bot.v1.CreateRequest
+	40, // 68: bot.v1.BotService.Get:input_type -> bot.v1.GetRequest
+	42, // 69: bot.v1.BotService.TradingControl:input_type -> bot.v1.TradingControlRequest
+	44, // 70: bot.v1.BotService.GetTradingState:input_type -> bot.v1.GetTradingStateRequest
+	46, // 71: bot.v1.BotService.ListRuns:input_type -> bot.v1.ListRunsRequest
+	51, // 72: bot.v1.BotService.Preview:input_type -> bot.v1.PreviewRequest
+	53, // 73: bot.v1.BotService.OpenNow:input_type -> bot.v1.OpenNowRequest
+	55, // 74: bot.v1.BotService.ClosePosition:input_type -> bot.v1.ClosePositionRequest
+	39, // 75: bot.v1.BotService.Create:output_type -> bot.v1.CreateResponse
+	41, // 76: bot.v1.BotService.Get:output_type -> bot.v1.GetResponse
+	43, // 77: bot.v1.BotService.TradingControl:output_type -> bot.v1.TradingControlResponse
+	45, // 78: bot.v1.BotService.GetTradingState:output_type -> bot.v1.GetTradingStateResponse
+	47, // 79: bot.v1.BotService.ListRuns:output_type -> bot.v1.ListRunsResponse
+	52, // 80: bot.v1.BotService.Preview:output_type -> bot.v1.PreviewResponse
+	54, // 81: bot.v1.BotService.OpenNow:output_type -> bot.v1.OpenNowResponse
+	56, // 82: bot.v1.BotService.ClosePosition:output_type -> bot.v1.ClosePositionResponse
+	75, // [75:83] is the sub-list for method output_type
+	67, // [67:75] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_bot_v1_bot_proto_init() }
@@ -1521,8 +5149,104 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntRange); i {
+		file_bot_v1_bot_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Strike); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Liquidity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DTE); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Leg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Allocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeekdaysChooser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Time); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Condition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1533,8 +5257,8 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Strike); i {
+		file_bot_v1_bot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interval); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1545,8 +5269,8 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DTE); i {
+		file_bot_v1_bot_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1557,8 +5281,8 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Leg); i {
+		file_bot_v1_bot_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProfitTarget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1569,8 +5293,8 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Allocation); i {
+		file_bot_v1_bot_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrailingStop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1581,8 +5305,8 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WeekdaysChooser); i {
+		file_bot_v1_bot_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Exit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1593,8 +5317,8 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Time); i {
+		file_bot_v1_bot_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Adjustment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1605,8 +5329,8 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Entry); i {
+		file_bot_v1_bot_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Expiry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1617,8 +5341,8 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Exit); i {
+		file_bot_v1_bot_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Setting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1629,8 +5353,8 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Setting); i {
+		file_bot_v1_bot_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1641,7 +5365,91 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_bot_v1_bot_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PositionLeg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Position); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunLeg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Run); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradingState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradingControlEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlattenProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRequest); i {
 			case 0:
 				return &v.state
@@ -1653,7 +5461,7 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_bot_v1_bot_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateResponse); i {
 			case 0:
 				return &v.state
@@ -1665,7 +5473,7 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_bot_v1_bot_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRequest); i {
 			case 0:
 				return &v.state
@@ -1677,7 +5485,7 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
-		file_bot_v1_bot_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_bot_v1_bot_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetResponse); i {
 			case 0:
 				return &v.state
@@ -1689,14 +5497,195 @@ func file_bot_v1_bot_proto_init() {
 				return nil
 			}
 		}
+		file_bot_v1_bot_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradingControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradingControlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTradingStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTradingStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRunsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRunsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderLeg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderPreview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoffPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenNowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenNowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePositionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bot_v1_bot_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePositionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_bot_v1_bot_proto_msgTypes[9].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_bot_v1_bot_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   15,
+			NumEnums:      12,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -37,19 +37,50 @@ const (
 	BotServiceCreateProcedure = "/bot.v1.BotService/Create"
 	// BotServiceGetProcedure is the fully-qualified name of the BotService's Get RPC.
 	BotServiceGetProcedure = "/bot.v1.BotService/Get"
+	// BotServiceTradingControlProcedure is the fully-qualified name of the BotService's TradingControl
+	// RPC.
+	BotServiceTradingControlProcedure = "/bot.v1.BotService/TradingControl"
+	// BotServiceGetTradingStateProcedure is the fully-qualified name of the BotService's
+	// GetTradingState RPC.
+	BotServiceGetTradingStateProcedure = "/bot.v1.BotService/GetTradingState"
+	// BotServiceListRunsProcedure is the fully-qualified name of the BotService's ListRuns RPC.
+	BotServiceListRunsProcedure = "/bot.v1.BotService/ListRuns"
+	// BotServicePreviewProcedure is the fully-qualified name of the BotService's Preview RPC.
+	BotServicePreviewProcedure = "/bot.v1.BotService/Preview"
+	// BotServiceOpenNowProcedure is the fully-qualified name of the BotService's OpenNow RPC.
+	BotServiceOpenNowProcedure = "/bot.v1.BotService/OpenNow"
+	// BotServiceClosePositionProcedure is the fully-qualified name of the BotService's ClosePosition
+	// RPC.
+	BotServiceClosePositionProcedure = "/bot.v1.BotService/ClosePosition"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	botServiceServiceDescriptor      = v1.File_bot_v1_bot_proto.Services().ByName("BotService")
-	botServiceCreateMethodDescriptor = botServiceServiceDescriptor.Methods().ByName("Create")
-	botServiceGetMethodDescriptor    = botServiceServiceDescriptor.Methods().ByName("Get")
+	botServiceServiceDescriptor               = v1.File_bot_v1_bot_proto.Services().ByName("BotService")
+	botServiceCreateMethodDescriptor          = botServiceServiceDescriptor.Methods().ByName("Create")
+	botServiceGetMethodDescriptor             = botServiceServiceDescriptor.Methods().ByName("Get")
+	botServiceTradingControlMethodDescriptor  = botServiceServiceDescriptor.Methods().ByName("TradingControl")
+	botServiceGetTradingStateMethodDescriptor = botServiceServiceDescriptor.Methods().ByName("GetTradingState")
+	botServiceListRunsMethodDescriptor        = botServiceServiceDescriptor.Methods().ByName("ListRuns")
+	botServicePreviewMethodDescriptor         = botServiceServiceDescriptor.Methods().ByName("Preview")
+	botServiceOpenNowMethodDescriptor         = botServiceServiceDescriptor.Methods().ByName("OpenNow")
+	botServiceClosePositionMethodDescriptor   = botServiceServiceDescriptor.Methods().ByName("ClosePosition")
 )
 
 // BotServiceClient is a client for the bot.v1.BotService service.
 type BotServiceClient interface {
 	Create(context.Context, *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error)
 	Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error)
+	// TradingControl halts or resumes automated trading of all bots, the state survives restarts
+	TradingControl(context.Context, *connect.Request[v1.TradingControlRequest]) (*connect.Response[v1.TradingControlResponse], error)
+	GetTradingState(context.Context, *connect.Request[v1.GetTradingStateRequest]) (*connect.Response[v1.GetTradingStateResponse], error)
+	ListRuns(context.Context, *connect.Request[v1.ListRunsRequest]) (*connect.Response[v1.ListRunsResponse], error)
+	// Preview resolves the bot against live chains and checks the risk without placing any order
+	Preview(context.Context, *connect.Request[v1.PreviewRequest]) (*connect.Response[v1.PreviewResponse], error)
+	// OpenNow opens a position of the bot immediately regardless of its entry, the risk checks still apply,
+	// fails with FAILED_PRECONDITION if halted or vetoed, the run of a failure can be found by ListRuns
+	OpenNow(context.Context, *connect.Request[v1.OpenNowRequest]) (*connect.Response[v1.OpenNowResponse], error)
+	ClosePosition(context.Context, *connect.Request[v1.ClosePositionRequest]) (*connect.Response[v1.ClosePositionResponse], error)
 }
 
 // NewBotServiceClient constructs a client for the bot.v1.BotService service. By default, it uses
@@ -74,13 +105,55 @@ func NewBotServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(botServiceGetMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		tradingControl: connect.NewClient[v1.TradingControlRequest, v1.TradingControlResponse](
+			httpClient,
+			baseURL+BotServiceTradingControlProcedure,
+			connect.WithSchema(botServiceTradingControlMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getTradingState: connect.NewClient[v1.GetTradingStateRequest, v1.GetTradingStateResponse](
+			httpClient,
+			baseURL+BotServiceGetTradingStateProcedure,
+			connect.WithSchema(botServiceGetTradingStateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listRuns: connect.NewClient[v1.ListRunsRequest, v1.ListRunsResponse](
+			httpClient,
+			baseURL+BotServiceListRunsProcedure,
+			connect.WithSchema(botServiceListRunsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		preview: connect.NewClient[v1.PreviewRequest, v1.PreviewResponse](
+			httpClient,
+			baseURL+BotServicePreviewProcedure,
+			connect.WithSchema(botServicePreviewMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		openNow: connect.NewClient[v1.OpenNowRequest, v1.OpenNowResponse](
+			httpClient,
+			baseURL+BotServiceOpenNowProcedure,
+			connect.WithSchema(botServiceOpenNowMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		closePosition: connect.NewClient[v1.ClosePositionRequest, v1.ClosePositionResponse](
+			httpClient,
+			baseURL+BotServiceClosePositionProcedure,
+			connect.WithSchema(botServiceClosePositionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // botServiceClient implements BotServiceClient.
 type botServiceClient struct {
-	create *connect.Client[v1.CreateRequest, v1.CreateResponse]
-	get    *connect.Client[v1.GetRequest, v1.GetResponse]
+	create          *connect.Client[v1.CreateRequest, v1.CreateResponse]
+	get             *connect.Client[v1.GetRequest, v1.GetResponse]
+	tradingControl  *connect.Client[v1.TradingControlRequest, v1.TradingControlResponse]
+	getTradingState *connect.Client[v1.GetTradingStateRequest, v1.GetTradingStateResponse]
+	listRuns        *connect.Client[v1.ListRunsRequest, v1.ListRunsResponse]
+	preview         *connect.Client[v1.PreviewRequest, v1.PreviewResponse]
+	openNow         *connect.Client[v1.OpenNowRequest, v1.OpenNowResponse]
+	closePosition   *connect.Client[v1.ClosePositionRequest, v1.ClosePositionResponse]
 }
 
 // Create calls bot.v1.BotService.Create.
@@ -93,10 +166,50 @@ func (c *botServiceClient) Get(ctx context.Context, req *connect.Request[v1.GetR
 	return c.get.CallUnary(ctx, req)
 }
 
+// TradingControl calls bot.v1.BotService.TradingControl.
+func (c *botServiceClient) TradingControl(ctx context.Context, req *connect.Request[v1.TradingControlRequest]) (*connect.Response[v1.TradingControlResponse], error) {
+	return c.tradingControl.CallUnary(ctx, req)
+}
+
+// GetTradingState calls bot.v1.BotService.GetTradingState.
+func (c *botServiceClient) GetTradingState(ctx context.Context, req *connect.Request[v1.GetTradingStateRequest]) (*connect.Response[v1.GetTradingStateResponse], error) {
+	return c.getTradingState.CallUnary(ctx, req)
+}
+
+// ListRuns calls bot.v1.BotService.ListRuns.
+func (c *botServiceClient) ListRuns(ctx context.Context, req *connect.Request[v1.ListRunsRequest]) (*connect.Response[v1.ListRunsResponse], error) {
+	return c.listRuns.CallUnary(ctx, req)
+}
+
+// Preview calls bot.v1.BotService.Preview.
+func (c *botServiceClient) Preview(ctx context.Context, req *connect.Request[v1.PreviewRequest]) (*connect.Response[v1.PreviewResponse], error) {
+	return c.preview.CallUnary(ctx, req)
+}
+
+// OpenNow calls bot.v1.BotService.OpenNow.
+func (c *botServiceClient) OpenNow(ctx context.Context, req *connect.Request[v1.OpenNowRequest]) (*connect.Response[v1.OpenNowResponse], error) {
+	return c.openNow.CallUnary(ctx, req)
+}
+
+// ClosePosition calls bot.v1.BotService.ClosePosition.
+func (c *botServiceClient) ClosePosition(ctx context.Context, req *connect.Request[v1.ClosePositionRequest]) (*connect.Response[v1.ClosePositionResponse], error) {
+	return c.closePosition.CallUnary(ctx, req)
+}
+
 // BotServiceHandler is an implementation of the bot.v1.BotService service.
 type BotServiceHandler interface {
 	Create(context.Context, *connect.Request[v1.CreateRequest]) (*connect.Response[v1.CreateResponse], error)
 	Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error)
+	// TradingControl halts or resumes automated trading of all bots, the state survives restarts
+	TradingControl(context.Context, *connect.Request[v1.TradingControlRequest]) (*connect.Response[v1.TradingControlResponse], error)
+	GetTradingState(context.Context, *connect.Request[v1.GetTradingStateRequest]) (*connect.Response[v1.GetTradingStateResponse], error)
+	ListRuns(context.Context, *connect.Request[v1.ListRunsRequest]) (*connect.Response[v1.ListRunsResponse], error)
+	// Preview resolves the bot against live chains and checks the risk without placing any order
+	Preview(context.Context, *connect.Request[v1.PreviewRequest]) (*connect.Response[v1.PreviewResponse], error)
+	// OpenNow opens a position of the bot immediately regardless of its entry, the risk checks still apply,
+	// fails with FAILED_PRECONDITION if halted or vetoed, the run of a failure can be found by ListRuns
+	OpenNow(context.Context, *connect.Request[v1.OpenNowRequest]) (*connect.Response[v1.OpenNowResponse], error)
+	ClosePosition(context.Context, *connect.Request[v1.ClosePositionRequest]) (*connect.Response[v1.ClosePositionResponse], error)
 }
 
 // NewBotServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -117,12 +230,60 @@ func NewBotServiceHandler(svc BotServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(botServiceGetMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	botServiceTradingControlHandler := connect.NewUnaryHandler(
+		BotServiceTradingControlProcedure,
+		svc.TradingControl,
+		connect.WithSchema(botServiceTradingControlMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	botServiceGetTradingStateHandler := connect.NewUnaryHandler(
+		BotServiceGetTradingStateProcedure,
+		svc.GetTradingState,
+		connect.WithSchema(botServiceGetTradingStateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	botServiceListRunsHandler := connect.NewUnaryHandler(
+		BotServiceListRunsProcedure,
+		svc.ListRuns,
+		connect.WithSchema(botServiceListRunsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	botServicePreviewHandler := connect.NewUnaryHandler(
+		BotServicePreviewProcedure,
+		svc.Preview,
+		connect.WithSchema(botServicePreviewMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	botServiceOpenNowHandler := connect.NewUnaryHandler(
+		BotServiceOpenNowProcedure,
+		svc.OpenNow,
+		connect.WithSchema(botServiceOpenNowMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	botServiceClosePositionHandler := connect.NewUnaryHandler(
+		BotServiceClosePositionProcedure,
+		svc.ClosePosition,
+		connect.WithSchema(botServiceClosePositionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/bot.v1.BotService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BotServiceCreateProcedure:
 			botServiceCreateHandler.ServeHTTP(w, r)
 		case BotServiceGetProcedure:
 			botServiceGetHandler.ServeHTTP(w, r)
+		case BotServiceTradingControlProcedure:
+			botServiceTradingControlHandler.ServeHTTP(w, r)
+		case BotServiceGetTradingStateProcedure:
+			botServiceGetTradingStateHandler.ServeHTTP(w, r)
+		case BotServiceListRunsProcedure:
+			botServiceListRunsHandler.ServeHTTP(w, r)
+		case BotServicePreviewProcedure:
+			botServicePreviewHandler.ServeHTTP(w, r)
+		case BotServiceOpenNowProcedure:
+			botServiceOpenNowHandler.ServeHTTP(w, r)
+		case BotServiceClosePositionProcedure:
+			botServiceClosePositionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -139,3 +300,27 @@ func (UnimplementedBotServiceHandler) Create(context.Context, *connect.Request[v
 func (UnimplementedBotServiceHandler) Get(context.Context, *connect.Request[v1.GetRequest]) (*connect.Response[v1.GetResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bot.v1.BotService.Get is not implemented"))
 }
+
+func (UnimplementedBotServiceHandler) TradingControl(context.Context, *connect.Request[v1.TradingControlRequest]) (*connect.Response[v1.TradingControlResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bot.v1.BotService.TradingControl is not implemented"))
+}
+
+func (UnimplementedBotServiceHandler) GetTradingState(context.Context, *connect.Request[v1.GetTradingStateRequest]) (*connect.Response[v1.GetTradingStateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bot.v1.BotService.GetTradingState is not implemented"))
+}
+
+func (UnimplementedBotServiceHandler) ListRuns(context.Context, *connect.Request[v1.ListRunsRequest]) (*connect.Response[v1.ListRunsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bot.v1.BotService.ListRuns is not implemented"))
+}
+
+func (UnimplementedBotServiceHandler) Preview(context.Context, *connect.Request[v1.PreviewRequest]) (*connect.Response[v1.PreviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bot.v1.BotService.Preview is not implemented"))
+}
+
+func (UnimplementedBotServiceHandler) OpenNow(context.Context, *connect.Request[v1.OpenNowRequest]) (*connect.Response[v1.OpenNowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bot.v1.BotService.OpenNow is not implemented"))
+}
+
+func (UnimplementedBotServiceHandler) ClosePosition(context.Context, *connect.Request[v1.ClosePositionRequest]) (*connect.Response[v1.ClosePositionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bot.v1.BotService.ClosePosition is not implemented"))
+}
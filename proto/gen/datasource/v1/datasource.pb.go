@@ -9,6 +9,7 @@ package datasourcev1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -75,6 +76,55 @@ func (SymbolType) EnumDescriptor() ([]byte, []int) {
 	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{0}
 }
 
+type OptionSide int32
+
+const (
+	OptionSide_OPTION_SIDE_UNSPECIFIED OptionSide = 0 // both calls and puts
+	OptionSide_OPTION_SIDE_CALL        OptionSide = 1
+	OptionSide_OPTION_SIDE_PUT         OptionSide = 2
+)
+
+// Enum value maps for OptionSide.
+var (
+	OptionSide_name = map[int32]string{
+		0: "OPTION_SIDE_UNSPECIFIED",
+		1: "OPTION_SIDE_CALL",
+		2: "OPTION_SIDE_PUT",
+	}
+	OptionSide_value = map[string]int32{
+		"OPTION_SIDE_UNSPECIFIED": 0,
+		"OPTION_SIDE_CALL":        1,
+		"OPTION_SIDE_PUT":         2,
+	}
+)
+
+func (x OptionSide) Enum() *OptionSide {
+	p := new(OptionSide)
+	*p = x
+	return p
+}
+
+func (x OptionSide) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OptionSide) Descriptor() protoreflect.EnumDescriptor {
+	return file_datasource_v1_datasource_proto_enumTypes[1].Descriptor()
+}
+
+func (OptionSide) Type() protoreflect.EnumType {
+	return &file_datasource_v1_datasource_proto_enumTypes[1]
+}
+
+func (x OptionSide) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OptionSide.Descriptor instead.
+func (OptionSide) EnumDescriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{1}
+}
+
 type Option struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -95,6 +145,18 @@ type Option struct {
 	Gamma           float64 `protobuf:"fixed64,13,opt,name=gamma,proto3" json:"gamma,omitempty"`
 	Vega            float64 `protobuf:"fixed64,14,opt,name=vega,proto3" json:"vega,omitempty"`
 	Theta           float64 `protobuf:"fixed64,15,opt,name=theta,proto3" json:"theta,omitempty"`
+	// the last trade
+	Last       float64 `protobuf:"fixed64,16,opt,name=last,proto3" json:"last,omitempty"`
+	LastVolume int64   `protobuf:"varint,17,opt,name=last_volume,json=lastVolume,proto3" json:"last_volume,omitempty"`
+	LastAt     int64   `protobuf:"varint,18,opt,name=last_at,json=lastAt,proto3" json:"last_at,omitempty"` // unix timestamp in ms
+	// change of the last price from the previous close
+	Change float64 `protobuf:"fixed64,19,opt,name=change,proto3" json:"change,omitempty"`
+	// contracts traded today
+	Volume       int64 `protobuf:"varint,20,opt,name=volume,proto3" json:"volume,omitempty"`
+	OpenInterest int64 `protobuf:"varint,21,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	// iv is of the bid if the broker reports them apart, e.g. Tradier
+	MidIv float64 `protobuf:"fixed64,22,opt,name=mid_iv,json=midIv,proto3" json:"mid_iv,omitempty"`
+	AskIv float64 `protobuf:"fixed64,23,opt,name=ask_iv,json=askIv,proto3" json:"ask_iv,omitempty"`
 }
 
 func (x *Option) Reset() {
@@ -234,6 +296,62 @@ func (x *Option) GetTheta() float64 {
 	return 0
 }
 
+func (x *Option) GetLast() float64 {
+	if x != nil {
+		return x.Last
+	}
+	return 0
+}
+
+func (x *Option) GetLastVolume() int64 {
+	if x != nil {
+		return x.LastVolume
+	}
+	return 0
+}
+
+func (x *Option) GetLastAt() int64 {
+	if x != nil {
+		return x.LastAt
+	}
+	return 0
+}
+
+func (x *Option) GetChange() float64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *Option) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Option) GetOpenInterest() int64 {
+	if x != nil {
+		return x.OpenInterest
+	}
+	return 0
+}
+
+func (x *Option) GetMidIv() float64 {
+	if x != nil {
+		return x.MidIv
+	}
+	return 0
+}
+
+func (x *Option) GetAskIv() float64 {
+	if x != nil {
+		return x.AskIv
+	}
+	return 0
+}
+
 type Chain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -313,6 +431,133 @@ func (x *Chain) GetPuts() []*Option {
 	return nil
 }
 
+type Quote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol           string  `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Last             float64 `protobuf:"fixed64,2,opt,name=last,proto3" json:"last,omitempty"`
+	Bid              float64 `protobuf:"fixed64,3,opt,name=bid,proto3" json:"bid,omitempty"`
+	Ask              float64 `protobuf:"fixed64,4,opt,name=ask,proto3" json:"ask,omitempty"`
+	Open             float64 `protobuf:"fixed64,5,opt,name=open,proto3" json:"open,omitempty"`
+	High             float64 `protobuf:"fixed64,6,opt,name=high,proto3" json:"high,omitempty"`
+	Low              float64 `protobuf:"fixed64,7,opt,name=low,proto3" json:"low,omitempty"`
+	PrevClose        float64 `protobuf:"fixed64,8,opt,name=prev_close,json=prevClose,proto3" json:"prev_close,omitempty"`
+	ChangePercentage float64 `protobuf:"fixed64,9,opt,name=change_percentage,json=changePercentage,proto3" json:"change_percentage,omitempty"`
+	Volume           int64   `protobuf:"varint,10,opt,name=volume,proto3" json:"volume,omitempty"`
+	QuoteAt          int64   `protobuf:"varint,11,opt,name=quote_at,json=quoteAt,proto3" json:"quote_at,omitempty"` // unix timestamp in ms
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_datasource_v1_datasource_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_datasource_v1_datasource_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Quote) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Quote) GetLast() float64 {
+	if x != nil {
+		return x.Last
+	}
+	return 0
+}
+
+func (x *Quote) GetBid() float64 {
+	if x != nil {
+		return x.Bid
+	}
+	return 0
+}
+
+func (x *Quote) GetAsk() float64 {
+	if x != nil {
+		return x.Ask
+	}
+	return 0
+}
+
+func (x *Quote) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *Quote) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Quote) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Quote) GetPrevClose() float64 {
+	if x != nil {
+		return x.PrevClose
+	}
+	return 0
+}
+
+func (x *Quote) GetChangePercentage() float64 {
+	if x != nil {
+		return x.ChangePercentage
+	}
+	return 0
+}
+
+func (x *Quote) GetVolume() int64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Quote) GetQuoteAt() int64 {
+	if x != nil {
+		return x.QuoteAt
+	}
+	return 0
+}
+
 type Symbol struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -326,7 +571,7 @@ type Symbol struct {
 func (x *Symbol) Reset() {
 	*x = Symbol{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_datasource_v1_datasource_proto_msgTypes[2]
+		mi := &file_datasource_v1_datasource_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -339,7 +584,7 @@ func (x *Symbol) String() string {
 func (*Symbol) ProtoMessage() {}
 
 func (x *Symbol) ProtoReflect() protoreflect.Message {
-	mi := &file_datasource_v1_datasource_proto_msgTypes[2]
+	mi := &file_datasource_v1_datasource_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,7 +597,7 @@ func (x *Symbol) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Symbol.ProtoReflect.Descriptor instead.
 func (*Symbol) Descriptor() ([]byte, []int) {
-	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{2}
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Symbol) GetSymbol() string {
@@ -385,12 +630,19 @@ type TradePeriod struct {
 	IsOpen  bool   `protobuf:"varint,2,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
 	OpenAt  int64  `protobuf:"varint,3,opt,name=open_at,json=openAt,proto3" json:"open_at,omitempty"`    // unix timestamp in ms
 	CloseAt int64  `protobuf:"varint,4,opt,name=close_at,json=closeAt,proto3" json:"close_at,omitempty"` // unix timestamp in ms
+	// extended hours, 0 if the market is closed
+	PremarketOpenAt   int64 `protobuf:"varint,5,opt,name=premarket_open_at,json=premarketOpenAt,proto3" json:"premarket_open_at,omitempty"`       // unix timestamp in ms
+	PostmarketCloseAt int64 `protobuf:"varint,6,opt,name=postmarket_close_at,json=postmarketCloseAt,proto3" json:"postmarket_close_at,omitempty"` // unix timestamp in ms
+	// closes earlier than the regular 16:00, e.g. the day after Thanksgiving
+	IsEarlyClose bool `protobuf:"varint,7,opt,name=is_early_close,json=isEarlyClose,proto3" json:"is_early_close,omitempty"`
+	// e.g. "Market is closed for Thanksgiving Day"
+	Description string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
 }
 
 func (x *TradePeriod) Reset() {
 	*x = TradePeriod{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_datasource_v1_datasource_proto_msgTypes[3]
+		mi := &file_datasource_v1_datasource_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -403,7 +655,7 @@ func (x *TradePeriod) String() string {
 func (*TradePeriod) ProtoMessage() {}
 
 func (x *TradePeriod) ProtoReflect() protoreflect.Message {
-	mi := &file_datasource_v1_datasource_proto_msgTypes[3]
+	mi := &file_datasource_v1_datasource_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +668,7 @@ func (x *TradePeriod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TradePeriod.ProtoReflect.Descriptor instead.
 func (*TradePeriod) Descriptor() ([]byte, []int) {
-	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{3}
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TradePeriod) GetDate() string {
@@ -447,18 +699,51 @@ func (x *TradePeriod) GetCloseAt() int64 {
 	return 0
 }
 
+func (x *TradePeriod) GetPremarketOpenAt() int64 {
+	if x != nil {
+		return x.PremarketOpenAt
+	}
+	return 0
+}
+
+func (x *TradePeriod) GetPostmarketCloseAt() int64 {
+	if x != nil {
+		return x.PostmarketCloseAt
+	}
+	return 0
+}
+
+func (x *TradePeriod) GetIsEarlyClose() bool {
+	if x != nil {
+		return x.IsEarlyClose
+	}
+	return false
+}
+
+func (x *TradePeriod) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 type SetGlobalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// the primary source
 	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// tried in order when the previous sources fail or answer stale quotes
+	FallbackAccountIds []string `protobuf:"bytes,2,rep,name=fallback_account_ids,json=fallbackAccountIds,proto3" json:"fallback_account_ids,omitempty"`
+	// greeks of the option chains are taken from this account, empty to keep the greeks of the serving source
+	GreeksAccountId string `protobuf:"bytes,3,opt,name=greeks_account_id,json=greeksAccountId,proto3" json:"greeks_account_id,omitempty"`
 }
 
 func (x *SetGlobalRequest) Reset() {
 	*x = SetGlobalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_datasource_v1_datasource_proto_msgTypes[4]
+		mi := &file_datasource_v1_datasource_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -471,7 +756,7 @@ func (x *SetGlobalRequest) String() string {
 func (*SetGlobalRequest) ProtoMessage() {}
 
 func (x *SetGlobalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_datasource_v1_datasource_proto_msgTypes[4]
+	mi := &file_datasource_v1_datasource_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +769,7 @@ func (x *SetGlobalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetGlobalRequest.ProtoReflect.Descriptor instead.
 func (*SetGlobalRequest) Descriptor() ([]byte, []int) {
-	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{4}
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SetGlobalRequest) GetAccountId() string {
@@ -494,6 +779,20 @@ func (x *SetGlobalRequest) GetAccountId() string {
 	return ""
 }
 
+func (x *SetGlobalRequest) GetFallbackAccountIds() []string {
+	if x != nil {
+		return x.FallbackAccountIds
+	}
+	return nil
+}
+
+func (x *SetGlobalRequest) GetGreeksAccountId() string {
+	if x != nil {
+		return x.GreeksAccountId
+	}
+	return ""
+}
+
 type SetGlobalResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -503,7 +802,7 @@ type SetGlobalResponse struct {
 func (x *SetGlobalResponse) Reset() {
 	*x = SetGlobalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_datasource_v1_datasource_proto_msgTypes[5]
+		mi := &file_datasource_v1_datasource_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -516,7 +815,7 @@ func (x *SetGlobalResponse) String() string {
 func (*SetGlobalResponse) ProtoMessage() {}
 
 func (x *SetGlobalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_datasource_v1_datasource_proto_msgTypes[5]
+	mi := &file_datasource_v1_datasource_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +828,7 @@ func (x *SetGlobalResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetGlobalResponse.ProtoReflect.Descriptor instead.
 func (*SetGlobalResponse) Descriptor() ([]byte, []int) {
-	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{5}
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{6}
 }
 
 type SearchSymbolsRequest struct {
@@ -538,12 +837,14 @@ type SearchSymbolsRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// the market of this account answers, the global data source if empty
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 }
 
 func (x *SearchSymbolsRequest) Reset() {
 	*x = SearchSymbolsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_datasource_v1_datasource_proto_msgTypes[6]
+		mi := &file_datasource_v1_datasource_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -556,7 +857,7 @@ func (x *SearchSymbolsRequest) String() string {
 func (*SearchSymbolsRequest) ProtoMessage() {}
 
 func (x *SearchSymbolsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_datasource_v1_datasource_proto_msgTypes[6]
+	mi := &file_datasource_v1_datasource_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +870,7 @@ func (x *SearchSymbolsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchSymbolsRequest.ProtoReflect.Descriptor instead.
 func (*SearchSymbolsRequest) Descriptor() ([]byte, []int) {
-	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{6}
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SearchSymbolsRequest) GetQuery() string {
@@ -579,6 +880,13 @@ func (x *SearchSymbolsRequest) GetQuery() string {
 	return ""
 }
 
+func (x *SearchSymbolsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
 type SearchSymbolsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -590,7 +898,7 @@ type SearchSymbolsResponse struct {
 func (x *SearchSymbolsResponse) Reset() {
 	*x = SearchSymbolsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_datasource_v1_datasource_proto_msgTypes[7]
+		mi := &file_datasource_v1_datasource_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -603,7 +911,7 @@ func (x *SearchSymbolsResponse) String() string {
 func (*SearchSymbolsResponse) ProtoMessage() {}
 
 func (x *SearchSymbolsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_datasource_v1_datasource_proto_msgTypes[7]
+	mi := &file_datasource_v1_datasource_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,7 +924,7 @@ func (x *SearchSymbolsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchSymbolsResponse.ProtoReflect.Descriptor instead.
 func (*SearchSymbolsResponse) Descriptor() ([]byte, []int) {
-	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{7}
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SearchSymbolsResponse) GetSymbols() []*Symbol {
@@ -632,12 +940,14 @@ type GetOptionExpirationsRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Underlying string `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	// the market of this account answers, the global data source if empty
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 }
 
 func (x *GetOptionExpirationsRequest) Reset() {
 	*x = GetOptionExpirationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_datasource_v1_datasource_proto_msgTypes[8]
+		mi := &file_datasource_v1_datasource_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -650,7 +960,7 @@ func (x *GetOptionExpirationsRequest) String() string {
 func (*GetOptionExpirationsRequest) ProtoMessage() {}
 
 func (x *GetOptionExpirationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_datasource_v1_datasource_proto_msgTypes[8]
+	mi := &file_datasource_v1_datasource_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,7 +973,7 @@ func (x *GetOptionExpirationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOptionExpirationsRequest.ProtoReflect.Descriptor instead.
 func (*GetOptionExpirationsRequest) Descriptor() ([]byte, []int) {
-	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{8}
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetOptionExpirationsRequest) GetUnderlying() string {
@@ -673,6 +983,13 @@ func (x *GetOptionExpirationsRequest) GetUnderlying() string {
 	return ""
 }
 
+func (x *GetOptionExpirationsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
 type GetOptionExpirationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -684,7 +1001,7 @@ type GetOptionExpirationsResponse struct {
 func (x *GetOptionExpirationsResponse) Reset() {
 	*x = GetOptionExpirationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_datasource_v1_datasource_proto_msgTypes[9]
+		mi := &file_datasource_v1_datasource_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -697,7 +1014,7 @@ func (x *GetOptionExpirationsResponse) String() string {
 func (*GetOptionExpirationsResponse) ProtoMessage() {}
 
 func (x *GetOptionExpirationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_datasource_v1_datasource_proto_msgTypes[9]
+	mi := &file_datasource_v1_datasource_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +1027,7 @@ func (x *GetOptionExpirationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOptionExpirationsResponse.ProtoReflect.Descriptor instead.
 func (*GetOptionExpirationsResponse) Descriptor() ([]byte, []int) {
-	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{9}
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetOptionExpirationsResponse) GetExpirations() []string {
@@ -720,32 +1037,585 @@ func (x *GetOptionExpirationsResponse) GetExpirations() []string {
 	return nil
 }
 
-type GetOptionChainsRequest struct {
+// ChainFilter drops the options not matching every set condition, zero values are unbounded
+type ChainFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Underlying string `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
-	Expiration string `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
-}
-
-func (x *GetOptionChainsRequest) Reset() {
-	*x = GetOptionChainsRequest{}
+	// e.g. ["SPXW"], all roots if empty
+	Roots     []string   `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	Side      OptionSide `protobuf:"varint,2,opt,name=side,proto3,enum=datasource.v1.OptionSide" json:"side,omitempty"`
+	MinStrike float64    `protobuf:"fixed64,3,opt,name=min_strike,json=minStrike,proto3" json:"min_strike,omitempty"`
+	MaxStrike float64    `protobuf:"fixed64,4,opt,name=max_strike,json=maxStrike,proto3" json:"max_strike,omitempty"`
+	// keep N strikes below and above the strike nearest to the underlying price
+	StrikesAroundAtm int32 `protobuf:"varint,5,opt,name=strikes_around_atm,json=strikesAroundAtm,proto3" json:"strikes_around_atm,omitempty"`
+	// absolute delta, e.g. 0.05 to 0.3 for both calls and puts
+	MinAbsDelta     float64 `protobuf:"fixed64,6,opt,name=min_abs_delta,json=minAbsDelta,proto3" json:"min_abs_delta,omitempty"`
+	MaxAbsDelta     float64 `protobuf:"fixed64,7,opt,name=max_abs_delta,json=maxAbsDelta,proto3" json:"max_abs_delta,omitempty"`
+	MinOpenInterest int64   `protobuf:"varint,8,opt,name=min_open_interest,json=minOpenInterest,proto3" json:"min_open_interest,omitempty"`
+	MinVolume       int64   `protobuf:"varint,9,opt,name=min_volume,json=minVolume,proto3" json:"min_volume,omitempty"`
+}
+
+func (x *ChainFilter) Reset() {
+	*x = ChainFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_datasource_v1_datasource_proto_msgTypes[10]
+		mi := &file_datasource_v1_datasource_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetOptionChainsRequest) String() string {
+func (x *ChainFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOptionChainsRequest) ProtoMessage() {}
+func (*ChainFilter) ProtoMessage() {}
+
+func (x *ChainFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_datasource_v1_datasource_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainFilter.ProtoReflect.Descriptor instead.
+func (*ChainFilter) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChainFilter) GetRoots() []string {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+func (x *ChainFilter) GetSide() OptionSide {
+	if x != nil {
+		return x.Side
+	}
+	return OptionSide_OPTION_SIDE_UNSPECIFIED
+}
+
+func (x *ChainFilter) GetMinStrike() float64 {
+	if x != nil {
+		return x.MinStrike
+	}
+	return 0
+}
+
+func (x *ChainFilter) GetMaxStrike() float64 {
+	if x != nil {
+		return x.MaxStrike
+	}
+	return 0
+}
+
+func (x *ChainFilter) GetStrikesAroundAtm() int32 {
+	if x != nil {
+		return x.StrikesAroundAtm
+	}
+	return 0
+}
+
+func (x *ChainFilter) GetMinAbsDelta() float64 {
+	if x != nil {
+		return x.MinAbsDelta
+	}
+	return 0
+}
+
+func (x *ChainFilter) GetMaxAbsDelta() float64 {
+	if x != nil {
+		return x.MaxAbsDelta
+	}
+	return 0
+}
+
+func (x *ChainFilter) GetMinOpenInterest() int64 {
+	if x != nil {
+		return x.MinOpenInterest
+	}
+	return 0
+}
+
+func (x *ChainFilter) GetMinVolume() int64 {
+	if x != nil {
+		return x.MinVolume
+	}
+	return 0
+}
+
+type GetOptionChainsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Underlying string `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	Expiration string `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// the market of this account answers, the global data source if empty
+	AccountId string       `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Filter    *ChainFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// fields of Option to return, e.g. "symbol", "strike", "bid" and "ask" to drop the greeks, all if empty
+	OptionMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=option_mask,json=optionMask,proto3" json:"option_mask,omitempty"`
+}
+
+func (x *GetOptionChainsRequest) Reset() {
+	*x = GetOptionChainsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_datasource_v1_datasource_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOptionChainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionChainsRequest) ProtoMessage() {}
 
 func (x *GetOptionChainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_datasource_v1_datasource_proto_msgTypes[10]
+	mi := &file_datasource_v1_datasource_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionChainsRequest.ProtoReflect.Descriptor instead.
+func (*GetOptionChainsRequest) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetOptionChainsRequest) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *GetOptionChainsRequest) GetExpiration() string {
+	if x != nil {
+		return x.Expiration
+	}
+	return ""
+}
+
+func (x *GetOptionChainsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetOptionChainsRequest) GetFilter() *ChainFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetOptionChainsRequest) GetOptionMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.OptionMask
+	}
+	return nil
+}
+
+type GetOptionChainsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chains []*Chain `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+}
+
+func (x *GetOptionChainsResponse) Reset() {
+	*x = GetOptionChainsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_datasource_v1_datasource_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOptionChainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOptionChainsResponse) ProtoMessage() {}
+
+func (x *GetOptionChainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datasource_v1_datasource_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOptionChainsResponse.ProtoReflect.Descriptor instead.
+func (*GetOptionChainsResponse) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetOptionChainsResponse) GetChains() []*Chain {
+	if x != nil {
+		return x.Chains
+	}
+	return nil
+}
+
+type GetTradeCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // yyyy-mm-dd
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`     // yyyy-mm-dd, inclusive
+}
+
+func (x *GetTradeCalendarRequest) Reset() {
+	*x = GetTradeCalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_datasource_v1_datasource_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTradeCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeCalendarRequest) ProtoMessage() {}
+
+func (x *GetTradeCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_datasource_v1_datasource_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeCalendarRequest.ProtoReflect.Descriptor instead.
+func (*GetTradeCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTradeCalendarRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetTradeCalendarRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetTradeCalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Periods []*TradePeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (x *GetTradeCalendarResponse) Reset() {
+	*x = GetTradeCalendarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_datasource_v1_datasource_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTradeCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTradeCalendarResponse) ProtoMessage() {}
+
+func (x *GetTradeCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datasource_v1_datasource_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTradeCalendarResponse.ProtoReflect.Descriptor instead.
+func (*GetTradeCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetTradeCalendarResponse) GetPeriods() []*TradePeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type SourceHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId           string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Healthy             bool   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	ConsecutiveFailures int64  `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	Calls               int64  `protobuf:"varint,4,opt,name=calls,proto3" json:"calls,omitempty"`
+	Failures            int64  `protobuf:"varint,5,opt,name=failures,proto3" json:"failures,omitempty"`
+	// answers older than the max quote or greeks age
+	Stales        int64  `protobuf:"varint,6,opt,name=stales,proto3" json:"stales,omitempty"`
+	LastError     string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorAt   int64  `protobuf:"varint,8,opt,name=last_error_at,json=lastErrorAt,proto3" json:"last_error_at,omitempty"`       // unix timestamp in ms
+	LastSuccessAt int64  `protobuf:"varint,9,opt,name=last_success_at,json=lastSuccessAt,proto3" json:"last_success_at,omitempty"` // unix timestamp in ms
+	LastStaleAt   int64  `protobuf:"varint,10,opt,name=last_stale_at,json=lastStaleAt,proto3" json:"last_stale_at,omitempty"`      // unix timestamp in ms
+	// the source is tried after the healthy ones until then
+	CoolingUntil int64 `protobuf:"varint,11,opt,name=cooling_until,json=coolingUntil,proto3" json:"cooling_until,omitempty"` // unix timestamp in ms
+	// the last answer was stale, which cools the source down like a failure
+	Stale bool `protobuf:"varint,12,opt,name=stale,proto3" json:"stale,omitempty"`
+	// the cache hits and misses of the account by market method, shared by all data sources
+	CacheStats []*CacheStat `protobuf:"bytes,13,rep,name=cache_stats,json=cacheStats,proto3" json:"cache_stats,omitempty"`
+}
+
+func (x *SourceHealth) Reset() {
+	*x = SourceHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_datasource_v1_datasource_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SourceHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceHealth) ProtoMessage() {}
+
+func (x *SourceHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_datasource_v1_datasource_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceHealth.ProtoReflect.Descriptor instead.
+func (*SourceHealth) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SourceHealth) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SourceHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *SourceHealth) GetConsecutiveFailures() int64 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *SourceHealth) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *SourceHealth) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *SourceHealth) GetStales() int64 {
+	if x != nil {
+		return x.Stales
+	}
+	return 0
+}
+
+func (x *SourceHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *SourceHealth) GetLastErrorAt() int64 {
+	if x != nil {
+		return x.LastErrorAt
+	}
+	return 0
+}
+
+func (x *SourceHealth) GetLastSuccessAt() int64 {
+	if x != nil {
+		return x.LastSuccessAt
+	}
+	return 0
+}
+
+func (x *SourceHealth) GetLastStaleAt() int64 {
+	if x != nil {
+		return x.LastStaleAt
+	}
+	return 0
+}
+
+func (x *SourceHealth) GetCoolingUntil() int64 {
+	if x != nil {
+		return x.CoolingUntil
+	}
+	return 0
+}
+
+func (x *SourceHealth) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *SourceHealth) GetCacheStats() []*CacheStat {
+	if x != nil {
+		return x.CacheStats
+	}
+	return nil
+}
+
+type CacheStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// e.g. GetOptionChains
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Hits   int64  `protobuf:"varint,2,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses int64  `protobuf:"varint,3,opt,name=misses,proto3" json:"misses,omitempty"`
+}
+
+func (x *CacheStat) Reset() {
+	*x = CacheStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_datasource_v1_datasource_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStat) ProtoMessage() {}
+
+func (x *CacheStat) ProtoReflect() protoreflect.Message {
+	mi := &file_datasource_v1_datasource_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStat.ProtoReflect.Descriptor instead.
+func (*CacheStat) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CacheStat) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CacheStat) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheStat) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+type GetSourceHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSourceHealthRequest) Reset() {
+	*x = GetSourceHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_datasource_v1_datasource_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSourceHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSourceHealthRequest) ProtoMessage() {}
+
+func (x *GetSourceHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_datasource_v1_datasource_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,50 +1626,161 @@ func (x *GetOptionChainsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOptionChainsRequest.ProtoReflect.Descriptor instead.
-func (*GetOptionChainsRequest) Descriptor() ([]byte, []int) {
-	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetSourceHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetSourceHealthRequest) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetOptionChainsRequest) GetUnderlying() string {
+type GetSourceHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// in the order of failover
+	Sources         []*SourceHealth `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	GreeksAccountId string          `protobuf:"bytes,2,opt,name=greeks_account_id,json=greeksAccountId,proto3" json:"greeks_account_id,omitempty"`
+}
+
+func (x *GetSourceHealthResponse) Reset() {
+	*x = GetSourceHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_datasource_v1_datasource_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSourceHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSourceHealthResponse) ProtoMessage() {}
+
+func (x *GetSourceHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datasource_v1_datasource_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSourceHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetSourceHealthResponse) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetSourceHealthResponse) GetSources() []*SourceHealth {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *GetSourceHealthResponse) GetGreeksAccountId() string {
+	if x != nil {
+		return x.GreeksAccountId
+	}
+	return ""
+}
+
+type WatchOptionChainsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Underlying string `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	Expiration string `protobuf:"bytes,2,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// the market of this account is polled, the global data source if empty
+	AccountId string `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *WatchOptionChainsRequest) Reset() {
+	*x = WatchOptionChainsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_datasource_v1_datasource_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchOptionChainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOptionChainsRequest) ProtoMessage() {}
+
+func (x *WatchOptionChainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_datasource_v1_datasource_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOptionChainsRequest.ProtoReflect.Descriptor instead.
+func (*WatchOptionChainsRequest) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WatchOptionChainsRequest) GetUnderlying() string {
 	if x != nil {
 		return x.Underlying
 	}
 	return ""
 }
 
-func (x *GetOptionChainsRequest) GetExpiration() string {
+func (x *WatchOptionChainsRequest) GetExpiration() string {
 	if x != nil {
 		return x.Expiration
 	}
 	return ""
 }
 
-type GetOptionChainsResponse struct {
+func (x *WatchOptionChainsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type WatchOptionChainsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Chains []*Chain `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+	// the first response is a snapshot with full chains,
+	// the following ones only carry the options changed since the previous response
+	IsSnapshot bool     `protobuf:"varint,1,opt,name=is_snapshot,json=isSnapshot,proto3" json:"is_snapshot,omitempty"`
+	Chains     []*Chain `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains,omitempty"`
+	// symbols of the options gone from the chains since the previous response
+	RemovedSymbols []string `protobuf:"bytes,3,rep,name=removed_symbols,json=removedSymbols,proto3" json:"removed_symbols,omitempty"`
 }
 
-func (x *GetOptionChainsResponse) Reset() {
-	*x = GetOptionChainsResponse{}
+func (x *WatchOptionChainsResponse) Reset() {
+	*x = WatchOptionChainsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_datasource_v1_datasource_proto_msgTypes[11]
+		mi := &file_datasource_v1_datasource_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetOptionChainsResponse) String() string {
+func (x *WatchOptionChainsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOptionChainsResponse) ProtoMessage() {}
+func (*WatchOptionChainsResponse) ProtoMessage() {}
 
-func (x *GetOptionChainsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_datasource_v1_datasource_proto_msgTypes[11]
+func (x *WatchOptionChainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_datasource_v1_datasource_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,143 +1791,334 @@ func (x *GetOptionChainsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOptionChainsResponse.ProtoReflect.Descriptor instead.
-func (*GetOptionChainsResponse) Descriptor() ([]byte, []int) {
-	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use WatchOptionChainsResponse.ProtoReflect.Descriptor instead.
+func (*WatchOptionChainsResponse) Descriptor() ([]byte, []int) {
+	return file_datasource_v1_datasource_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetOptionChainsResponse) GetChains() []*Chain {
+func (x *WatchOptionChainsResponse) GetIsSnapshot() bool {
+	if x != nil {
+		return x.IsSnapshot
+	}
+	return false
+}
+
+func (x *WatchOptionChainsResponse) GetChains() []*Chain {
 	if x != nil {
 		return x.Chains
 	}
 	return nil
 }
 
+func (x *WatchOptionChainsResponse) GetRemovedSymbols() []string {
+	if x != nil {
+		return x.RemovedSymbols
+	}
+	return nil
+}
+
 var File_datasource_v1_datasource_proto protoreflect.FileDescriptor
 
 var file_datasource_v1_datasource_proto_rawDesc = []byte{
 	0x0a, 0x1e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f,
 	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x22,
-	0xed, 0x02, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x62, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x62, 0x69, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x64, 0x41, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x73, 0x6b,
-	0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x61, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x61,
-	0x73, 0x6b, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x73, 0x6b,
-	0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x74, 0x12, 0x2a, 0x0a,
-	0x11, 0x67, 0x72, 0x65, 0x65, 0x6b, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x72, 0x65, 0x65, 0x6b, 0x73,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x76, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x69, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c,
-	0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12,
-	0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
-	0x67, 0x61, 0x6d, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x65, 0x67, 0x61, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x65, 0x67, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65,
-	0x74, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x22,
-	0xc0, 0x01, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x6f,
-	0x74, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x72, 0x6f, 0x6f, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e,
-	0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x61,
-	0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x70, 0x75, 0x74, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x70, 0x75,
-	0x74, 0x73, 0x22, 0x71, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x12, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a,
+	0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xbe, 0x04, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
 	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x6e, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6f,
-	0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x4f, 0x70, 0x65,
-	0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c,
-	0x6f, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6c,
-	0x6f, 0x73, 0x65, 0x41, 0x74, 0x22, 0x31, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x47,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a,
-	0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x48, 0x0a, 0x15, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x22, 0x3d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69,
-	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c,
-	0x79, 0x69, 0x6e, 0x67, 0x22, 0x40, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x58, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67,
-	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x47, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x2a, 0x84, 0x01, 0x0a, 0x0a, 0x53, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x59, 0x4d, 0x42,
-	0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
-	0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x53,
-	0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x54, 0x46, 0x10, 0x04,
-	0x32, 0x92, 0x03, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x47, 0x6c, 0x6f,
-	0x62, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x70, 0x61, 0x61, 0x6e, 0x6e, 0x67, 0x67, 0x67, 0x67, 0x2f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x62, 0x6f, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x76,
-	0x31, 0x3b, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x62, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x62, 0x69, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x64, 0x41, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61,
+	0x73, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x15, 0x0a,
+	0x06, 0x61, 0x73, 0x6b, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61,
+	0x73, 0x6b, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x67, 0x72, 0x65, 0x65, 0x6b, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x72, 0x65, 0x65,
+	0x6b, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x76, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x69, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x67, 0x61, 0x6d, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x65, 0x67, 0x61, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x76, 0x65, 0x67, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x68, 0x65, 0x74, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x68, 0x65, 0x74,
+	0x61, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x6c, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61,
+	0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x69, 0x64, 0x5f, 0x69, 0x76, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6d, 0x69, 0x64, 0x49, 0x76, 0x12, 0x15, 0x0a, 0x06, 0x61,
+	0x73, 0x6b, 0x5f, 0x69, 0x76, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61, 0x73, 0x6b,
+	0x49, 0x76, 0x22, 0xc0, 0x01, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x74, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a,
+	0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x70, 0x75,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x04, 0x70, 0x75, 0x74, 0x73, 0x22, 0x90, 0x02, 0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x62,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x62, 0x69, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x73, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6f,
+	0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x65,
+	0x76, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70,
+	0x72, 0x65, 0x76, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x41, 0x74, 0x22, 0x71, 0x0a, 0x06, 0x53, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x0b,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x69, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x41,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x70, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x6f, 0x73, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x65,
+	0x61, 0x72, 0x6c, 0x79, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x69, 0x73, 0x45, 0x61, 0x72, 0x6c, 0x79, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x8f, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x12, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x67, 0x72, 0x65, 0x65, 0x6b, 0x73,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x67, 0x72, 0x65, 0x65, 0x6b, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79,
+	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x52, 0x07, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x22, 0x5c,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd1,
+	0x02, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x6f, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x64, 0x65, 0x52, 0x04, 0x73,
+	0x69, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x72, 0x69,
+	0x6b, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x73, 0x5f, 0x61, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x73, 0x41, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x74, 0x6d, 0x12,
+	0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x62, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x41, 0x62, 0x73, 0x44, 0x65,
+	0x6c, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x62, 0x73, 0x5f, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41,
+	0x62, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x22, 0xe8, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x47, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x06,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x07,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0xc9, 0x03, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x41,
+	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x41, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6f, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6f, 0x6c, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x22, 0x4f, 0x0a, 0x09, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7c,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x67, 0x72, 0x65, 0x65, 0x6b, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x72, 0x65,
+	0x65, 0x6b, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x18,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65,
+	0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e,
+	0x64, 0x65, 0x72, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x19, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x2a, 0x84, 0x01,
+	0x0a, 0x0a, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17,
+	0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x59, 0x4d,
+	0x42, 0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x43, 0x4b, 0x10, 0x01,
+	0x12, 0x16, 0x0a, 0x12, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x59, 0x4d, 0x42,
+	0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x10, 0x03, 0x12,
+	0x13, 0x0a, 0x0f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45,
+	0x54, 0x46, 0x10, 0x04, 0x2a, 0x54, 0x0a, 0x0a, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
+	0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x44,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x43,
+	0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x49, 0x44, 0x45, 0x5f, 0x50, 0x55, 0x54, 0x10, 0x02, 0x32, 0xc3, 0x05, 0x0a, 0x11, 0x44,
+	0x61, 0x74, 0x61, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x4e, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x1f, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5a, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x73, 0x12, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x63, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x12, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
+	0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x70, 0x61, 0x61, 0x6e, 0x6e, 0x67, 0x67, 0x67, 0x67, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x2d, 0x62, 0x6f, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -961,42 +2133,67 @@ func file_datasource_v1_datasource_proto_rawDescGZIP() []byte {
 	return file_datasource_v1_datasource_proto_rawDescData
 }
 
-var file_datasource_v1_datasource_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_datasource_v1_datasource_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_datasource_v1_datasource_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_datasource_v1_datasource_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_datasource_v1_datasource_proto_goTypes = []interface{}{
 	(SymbolType)(0),                      // 0: datasource.v1.SymbolType
-	(*Option)(nil),                       // 1: datasource.v1.Option
-	(*Chain)(nil),                        // 2: datasource.v1.Chain
-	(*Symbol)(nil),                       // 3: datasource.v1.Symbol
-	(*TradePeriod)(nil),                  // 4: datasource.v1.TradePeriod
-	(*SetGlobalRequest)(nil),             // 5: datasource.v1.SetGlobalRequest
-	(*SetGlobalResponse)(nil),            // 6: datasource.v1.SetGlobalResponse
-	(*SearchSymbolsRequest)(nil),         // 7: datasource.v1.SearchSymbolsRequest
-	(*SearchSymbolsResponse)(nil),        // 8: datasource.v1.SearchSymbolsResponse
-	(*GetOptionExpirationsRequest)(nil),  // 9: datasource.v1.GetOptionExpirationsRequest
-	(*GetOptionExpirationsResponse)(nil), // 10: datasource.v1.GetOptionExpirationsResponse
-	(*GetOptionChainsRequest)(nil),       // 11: datasource.v1.GetOptionChainsRequest
-	(*GetOptionChainsResponse)(nil),      // 12: datasource.v1.GetOptionChainsResponse
+	(OptionSide)(0),                      // 1: datasource.v1.OptionSide
+	(*Option)(nil),                       // 2: datasource.v1.Option
+	(*Chain)(nil),                        // 3: datasource.v1.Chain
+	(*Quote)(nil),                        // 4: datasource.v1.Quote
+	(*Symbol)(nil),                       // 5: datasource.v1.Symbol
+	(*TradePeriod)(nil),                  // 6: datasource.v1.TradePeriod
+	(*SetGlobalRequest)(nil),             // 7: datasource.v1.SetGlobalRequest
+	(*SetGlobalResponse)(nil),            // 8: datasource.v1.SetGlobalResponse
+	(*SearchSymbolsRequest)(nil),         // 9: datasource.v1.SearchSymbolsRequest
+	(*SearchSymbolsResponse)(nil),        // 10: datasource.v1.SearchSymbolsResponse
+	(*GetOptionExpirationsRequest)(nil),  // 11: datasource.v1.GetOptionExpirationsRequest
+	(*GetOptionExpirationsResponse)(nil), // 12: datasource.v1.GetOptionExpirationsResponse
+	(*ChainFilter)(nil),                  // 13: datasource.v1.ChainFilter
+	(*GetOptionChainsRequest)(nil),       // 14: datasource.v1.GetOptionChainsRequest
+	(*GetOptionChainsResponse)(nil),      // 15: datasource.v1.GetOptionChainsResponse
+	(*GetTradeCalendarRequest)(nil),      // 16: datasource.v1.GetTradeCalendarRequest
+	(*GetTradeCalendarResponse)(nil),     // 17: datasource.v1.GetTradeCalendarResponse
+	(*SourceHealth)(nil),                 // 18: datasource.v1.SourceHealth
+	(*CacheStat)(nil),                    // 19: datasource.v1.CacheStat
+	(*GetSourceHealthRequest)(nil),       // 20: datasource.v1.GetSourceHealthRequest
+	(*GetSourceHealthResponse)(nil),      // 21: datasource.v1.GetSourceHealthResponse
+	(*WatchOptionChainsRequest)(nil),     // 22: datasource.v1.WatchOptionChainsRequest
+	(*WatchOptionChainsResponse)(nil),    // 23: datasource.v1.WatchOptionChainsResponse
+	(*fieldmaskpb.FieldMask)(nil),        // 24: google.protobuf.FieldMask
 }
 var file_datasource_v1_datasource_proto_depIdxs = []int32{
-	1,  // 0: datasource.v1.Chain.calls:type_name -> datasource.v1.Option
-	1,  // 1: datasource.v1.Chain.puts:type_name -> datasource.v1.Option
+	2,  // 0: datasource.v1.Chain.calls:type_name -> datasource.v1.Option
+	2,  // 1: datasource.v1.Chain.puts:type_name -> datasource.v1.Option
 	0,  // 2: datasource.v1.Symbol.type:type_name -> datasource.v1.SymbolType
-	3,  // 3: datasource.v1.SearchSymbolsResponse.symbols:type_name -> datasource.v1.Symbol
-	2,  // 4: datasource.v1.GetOptionChainsResponse.chains:type_name -> datasource.v1.Chain
-	5,  // 5: datasource.v1.DataSourceService.SetGlobal:input_type -> datasource.v1.SetGlobalRequest
-	7,  // 6: datasource.v1.DataSourceService.SearchSymbols:input_type -> datasource.v1.SearchSymbolsRequest
-	9,  // 7: datasource.v1.DataSourceService.GetOptionExpirations:input_type -> datasource.v1.GetOptionExpirationsRequest
-	11, // 8: datasource.v1.DataSourceService.GetOptionChains:input_type -> datasource.v1.GetOptionChainsRequest
-	6,  // 9: datasource.v1.DataSourceService.SetGlobal:output_type -> datasource.v1.SetGlobalResponse
-	8,  // 10: datasource.v1.DataSourceService.SearchSymbols:output_type -> datasource.v1.SearchSymbolsResponse
-	10, // 11: datasource.v1.DataSourceService.GetOptionExpirations:output_type -> datasource.v1.GetOptionExpirationsResponse
-	12, // 12: datasource.v1.DataSourceService.GetOptionChains:output_type -> datasource.v1.GetOptionChainsResponse
-	9,  // [9:13] is the sub-list for method output_type
-	5,  // [5:9] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	5,  // 3: datasource.v1.SearchSymbolsResponse.symbols:type_name -> datasource.v1.Symbol
+	1,  // 4: datasource.v1.ChainFilter.side:type_name -> datasource.v1.OptionSide
+	13, // 5: datasource.v1.GetOptionChainsRequest.filter:type_name -> datasource.v1.ChainFilter
+	24, // 6: datasource.v1.GetOptionChainsRequest.option_mask:type_name -> google.protobuf.FieldMask
+	3,  // 7: datasource.v1.GetOptionChainsResponse.chains:type_name -> datasource.v1.Chain
+	6,  // 8: datasource.v1.GetTradeCalendarResponse.periods:type_name -> datasource.v1.TradePeriod
+	19, // 9: datasource.v1.SourceHealth.cache_stats:type_name -> datasource.v1.CacheStat
+	18, // 10: datasource.v1.GetSourceHealthResponse.sources:type_name -> datasource.v1.SourceHealth
+	3,  // 11: datasource.v1.WatchOptionChainsResponse.chains:type_name -> datasource.v1.Chain
+	7,  // 12: datasource.v1.DataSourceService.SetGlobal:input_type -> datasource.v1.SetGlobalRequest
+	9,  // 13: datasource.v1.DataSourceService.SearchSymbols:input_type -> datasource.v1.SearchSymbolsRequest
+	11, // 14: datasource.v1.DataSourceService.GetOptionExpirations:input_type -> datasource.v1.GetOptionExpirationsRequest
+	14, // 15: datasource.v1.DataSourceService.GetOptionChains:input_type -> datasource.v1.GetOptionChainsRequest
+	16, // 16: datasource.v1.DataSourceService.GetTradeCalendar:input_type -> datasource.v1.GetTradeCalendarRequest
+	20, // 17: datasource.v1.DataSourceService.GetSourceHealth:input_type -> datasource.v1.GetSourceHealthRequest
+	22, // 18: datasource.v1.DataSourceService.WatchOptionChains:input_type -> datasource.v1.WatchOptionChainsRequest
+	8,  // 19: datasource.v1.DataSourceService.SetGlobal:output_type -> datasource.v1.SetGlobalResponse
+	10, // 20: datasource.v1.DataSourceService.SearchSymbols:output_type -> datasource.v1.SearchSymbolsResponse
+	12, // 21: datasource.v1.DataSourceService.GetOptionExpirations:output_type -> datasource.v1.GetOptionExpirationsResponse
+	15, // 22: datasource.v1.DataSourceService.GetOptionChains:output_type -> datasource.v1.GetOptionChainsResponse
+	17, // 23: datasource.v1.DataSourceService.GetTradeCalendar:output_type -> datasource.v1.GetTradeCalendarResponse
+	21, // 24: datasource.v1.DataSourceService.GetSourceHealth:output_type -> datasource.v1.GetSourceHealthResponse
+	23, // 25: datasource.v1.DataSourceService.WatchOptionChains:output_type -> datasource.v1.WatchOptionChainsResponse
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_datasource_v1_datasource_proto_init() }
@@ -1030,7 +2227,7 @@ func file_datasource_v1_datasource_proto_init() {
 			}
 		}
 		file_datasource_v1_datasource_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Symbol); i {
+			switch v := v.(*Quote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1042,7 +2239,7 @@ func file_datasource_v1_datasource_proto_init() {
 			}
 		}
 		file_datasource_v1_datasource_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TradePeriod); i {
+			switch v := v.(*Symbol); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1054,7 +2251,7 @@ func file_datasource_v1_datasource_proto_init() {
 			}
 		}
 		file_datasource_v1_datasource_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetGlobalRequest); i {
+			switch v := v.(*TradePeriod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1066,7 +2263,7 @@ func file_datasource_v1_datasource_proto_init() {
 			}
 		}
 		file_datasource_v1_datasource_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetGlobalResponse); i {
+			switch v := v.(*SetGlobalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1078,7 +2275,7 @@ func file_datasource_v1_datasource_proto_init() {
 			}
 		}
 		file_datasource_v1_datasource_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchSymbolsRequest); i {
+			switch v := v.(*SetGlobalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1090,7 +2287,7 @@ func file_datasource_v1_datasource_proto_init() {
 			}
 		}
 		file_datasource_v1_datasource_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchSymbolsResponse); i {
+			switch v := v.(*SearchSymbolsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1102,7 +2299,7 @@ func file_datasource_v1_datasource_proto_init() {
 			}
 		}
 		file_datasource_v1_datasource_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOptionExpirationsRequest); i {
+			switch v := v.(*SearchSymbolsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1114,7 +2311,7 @@ func file_datasource_v1_datasource_proto_init() {
 			}
 		}
 		file_datasource_v1_datasource_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOptionExpirationsResponse); i {
+			switch v := v.(*GetOptionExpirationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1126,7 +2323,7 @@ func file_datasource_v1_datasource_proto_init() {
 			}
 		}
 		file_datasource_v1_datasource_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetOptionChainsRequest); i {
+			switch v := v.(*GetOptionExpirationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1138,6 +2335,30 @@ func file_datasource_v1_datasource_proto_init() {
 			}
 		}
 		file_datasource_v1_datasource_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_datasource_v1_datasource_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOptionChainsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_datasource_v1_datasource_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetOptionChainsResponse); i {
 			case 0:
 				return &v.state
@@ -1149,14 +2370,110 @@ func file_datasource_v1_datasource_proto_init() {
 				return nil
 			}
 		}
+		file_datasource_v1_datasource_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTradeCalendarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_datasource_v1_datasource_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTradeCalendarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_datasource_v1_datasource_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SourceHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_datasource_v1_datasource_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_datasource_v1_datasource_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSourceHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_datasource_v1_datasource_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSourceHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_datasource_v1_datasource_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchOptionChainsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_datasource_v1_datasource_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchOptionChainsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_datasource_v1_datasource_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   12,
+			NumEnums:      2,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -45,6 +45,15 @@ const (
 	// DataSourceServiceGetOptionChainsProcedure is the fully-qualified name of the DataSourceService's
 	// GetOptionChains RPC.
 	DataSourceServiceGetOptionChainsProcedure = "/datasource.v1.DataSourceService/GetOptionChains"
+	// DataSourceServiceGetTradeCalendarProcedure is the fully-qualified name of the DataSourceService's
+	// GetTradeCalendar RPC.
+	DataSourceServiceGetTradeCalendarProcedure = "/datasource.v1.DataSourceService/GetTradeCalendar"
+	// DataSourceServiceGetSourceHealthProcedure is the fully-qualified name of the DataSourceService's
+	// GetSourceHealth RPC.
+	DataSourceServiceGetSourceHealthProcedure = "/datasource.v1.DataSourceService/GetSourceHealth"
+	// DataSourceServiceWatchOptionChainsProcedure is the fully-qualified name of the
+	// DataSourceService's WatchOptionChains RPC.
+	DataSourceServiceWatchOptionChainsProcedure = "/datasource.v1.DataSourceService/WatchOptionChains"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -54,6 +63,9 @@ var (
 	dataSourceServiceSearchSymbolsMethodDescriptor        = dataSourceServiceServiceDescriptor.Methods().ByName("SearchSymbols")
 	dataSourceServiceGetOptionExpirationsMethodDescriptor = dataSourceServiceServiceDescriptor.Methods().ByName("GetOptionExpirations")
 	dataSourceServiceGetOptionChainsMethodDescriptor      = dataSourceServiceServiceDescriptor.Methods().ByName("GetOptionChains")
+	dataSourceServiceGetTradeCalendarMethodDescriptor     = dataSourceServiceServiceDescriptor.Methods().ByName("GetTradeCalendar")
+	dataSourceServiceGetSourceHealthMethodDescriptor      = dataSourceServiceServiceDescriptor.Methods().ByName("GetSourceHealth")
+	dataSourceServiceWatchOptionChainsMethodDescriptor    = dataSourceServiceServiceDescriptor.Methods().ByName("WatchOptionChains")
 )
 
 // DataSourceServiceClient is a client for the datasource.v1.DataSourceService service.
@@ -62,6 +74,9 @@ type DataSourceServiceClient interface {
 	SearchSymbols(context.Context, *connect.Request[v1.SearchSymbolsRequest]) (*connect.Response[v1.SearchSymbolsResponse], error)
 	GetOptionExpirations(context.Context, *connect.Request[v1.GetOptionExpirationsRequest]) (*connect.Response[v1.GetOptionExpirationsResponse], error)
 	GetOptionChains(context.Context, *connect.Request[v1.GetOptionChainsRequest]) (*connect.Response[v1.GetOptionChainsResponse], error)
+	GetTradeCalendar(context.Context, *connect.Request[v1.GetTradeCalendarRequest]) (*connect.Response[v1.GetTradeCalendarResponse], error)
+	GetSourceHealth(context.Context, *connect.Request[v1.GetSourceHealthRequest]) (*connect.Response[v1.GetSourceHealthResponse], error)
+	WatchOptionChains(context.Context, *connect.Request[v1.WatchOptionChainsRequest]) (*connect.ServerStreamForClient[v1.WatchOptionChainsResponse], error)
 }
 
 // NewDataSourceServiceClient constructs a client for the datasource.v1.DataSourceService service.
@@ -98,6 +113,24 @@ func NewDataSourceServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(dataSourceServiceGetOptionChainsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getTradeCalendar: connect.NewClient[v1.GetTradeCalendarRequest, v1.GetTradeCalendarResponse](
+			httpClient,
+			baseURL+DataSourceServiceGetTradeCalendarProcedure,
+			connect.WithSchema(dataSourceServiceGetTradeCalendarMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getSourceHealth: connect.NewClient[v1.GetSourceHealthRequest, v1.GetSourceHealthResponse](
+			httpClient,
+			baseURL+DataSourceServiceGetSourceHealthProcedure,
+			connect.WithSchema(dataSourceServiceGetSourceHealthMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		watchOptionChains: connect.NewClient[v1.WatchOptionChainsRequest, v1.WatchOptionChainsResponse](
+			httpClient,
+			baseURL+DataSourceServiceWatchOptionChainsProcedure,
+			connect.WithSchema(dataSourceServiceWatchOptionChainsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -107,6 +140,9 @@ type dataSourceServiceClient struct {
 	searchSymbols        *connect.Client[v1.SearchSymbolsRequest, v1.SearchSymbolsResponse]
 	getOptionExpirations *connect.Client[v1.GetOptionExpirationsRequest, v1.GetOptionExpirationsResponse]
 	getOptionChains      *connect.Client[v1.GetOptionChainsRequest, v1.GetOptionChainsResponse]
+	getTradeCalendar     *connect.Client[v1.GetTradeCalendarRequest, v1.GetTradeCalendarResponse]
+	getSourceHealth      *connect.Client[v1.GetSourceHealthRequest, v1.GetSourceHealthResponse]
+	watchOptionChains    *connect.Client[v1.WatchOptionChainsRequest, v1.WatchOptionChainsResponse]
 }
 
 // SetGlobal calls datasource.v1.DataSourceService.SetGlobal.
@@ -129,12 +165,30 @@ func (c *dataSourceServiceClient) GetOptionChains(ctx context.Context, req *conn
 	return c.getOptionChains.CallUnary(ctx, req)
 }
 
+// GetTradeCalendar calls datasource.v1.DataSourceService.GetTradeCalendar.
+func (c *dataSourceServiceClient) GetTradeCalendar(ctx context.Context, req *connect.Request[v1.GetTradeCalendarRequest]) (*connect.Response[v1.GetTradeCalendarResponse], error) {
+	return c.getTradeCalendar.CallUnary(ctx, req)
+}
+
+// GetSourceHealth calls datasource.v1.DataSourceService.GetSourceHealth.
+func (c *dataSourceServiceClient) GetSourceHealth(ctx context.Context, req *connect.Request[v1.GetSourceHealthRequest]) (*connect.Response[v1.GetSourceHealthResponse], error) {
+	return c.getSourceHealth.CallUnary(ctx, req)
+}
+
+// WatchOptionChains calls datasource.v1.DataSourceService.WatchOptionChains.
+func (c *dataSourceServiceClient) WatchOptionChains(ctx context.Context, req *connect.Request[v1.WatchOptionChainsRequest]) (*connect.ServerStreamForClient[v1.WatchOptionChainsResponse], error) {
+	return c.watchOptionChains.CallServerStream(ctx, req)
+}
+
 // DataSourceServiceHandler is an implementation of the datasource.v1.DataSourceService service.
 type DataSourceServiceHandler interface {
 	SetGlobal(context.Context, *connect.Request[v1.SetGlobalRequest]) (*connect.Response[v1.SetGlobalResponse], error)
 	SearchSymbols(context.Context, *connect.Request[v1.SearchSymbolsRequest]) (*connect.Response[v1.SearchSymbolsResponse], error)
 	GetOptionExpirations(context.Context, *connect.Request[v1.GetOptionExpirationsRequest]) (*connect.Response[v1.GetOptionExpirationsResponse], error)
 	GetOptionChains(context.Context, *connect.Request[v1.GetOptionChainsRequest]) (*connect.Response[v1.GetOptionChainsResponse], error)
+	GetTradeCalendar(context.Context, *connect.Request[v1.GetTradeCalendarRequest]) (*connect.Response[v1.GetTradeCalendarResponse], error)
+	GetSourceHealth(context.Context, *connect.Request[v1.GetSourceHealthRequest]) (*connect.Response[v1.GetSourceHealthResponse], error)
+	WatchOptionChains(context.Context, *connect.Request[v1.WatchOptionChainsRequest], *connect.ServerStream[v1.WatchOptionChainsResponse]) error
 }
 
 // NewDataSourceServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -167,6 +221,24 @@ func NewDataSourceServiceHandler(svc DataSourceServiceHandler, opts ...connect.H
 		connect.WithSchema(dataSourceServiceGetOptionChainsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	dataSourceServiceGetTradeCalendarHandler := connect.NewUnaryHandler(
+		DataSourceServiceGetTradeCalendarProcedure,
+		svc.GetTradeCalendar,
+		connect.WithSchema(dataSourceServiceGetTradeCalendarMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataSourceServiceGetSourceHealthHandler := connect.NewUnaryHandler(
+		DataSourceServiceGetSourceHealthProcedure,
+		svc.GetSourceHealth,
+		connect.WithSchema(dataSourceServiceGetSourceHealthMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	dataSourceServiceWatchOptionChainsHandler := connect.NewServerStreamHandler(
+		DataSourceServiceWatchOptionChainsProcedure,
+		svc.WatchOptionChains,
+		connect.WithSchema(dataSourceServiceWatchOptionChainsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/datasource.v1.DataSourceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case DataSourceServiceSetGlobalProcedure:
@@ -177,6 +249,12 @@ func NewDataSourceServiceHandler(svc DataSourceServiceHandler, opts ...connect.H
 			dataSourceServiceGetOptionExpirationsHandler.ServeHTTP(w, r)
 		case DataSourceServiceGetOptionChainsProcedure:
 			dataSourceServiceGetOptionChainsHandler.ServeHTTP(w, r)
+		case DataSourceServiceGetTradeCalendarProcedure:
+			dataSourceServiceGetTradeCalendarHandler.ServeHTTP(w, r)
+		case DataSourceServiceGetSourceHealthProcedure:
+			dataSourceServiceGetSourceHealthHandler.ServeHTTP(w, r)
+		case DataSourceServiceWatchOptionChainsProcedure:
+			dataSourceServiceWatchOptionChainsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -201,3 +279,15 @@ func (UnimplementedDataSourceServiceHandler) GetOptionExpirations(context.Contex
 func (UnimplementedDataSourceServiceHandler) GetOptionChains(context.Context, *connect.Request[v1.GetOptionChainsRequest]) (*connect.Response[v1.GetOptionChainsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("datasource.v1.DataSourceService.GetOptionChains is not implemented"))
 }
+
+func (UnimplementedDataSourceServiceHandler) GetTradeCalendar(context.Context, *connect.Request[v1.GetTradeCalendarRequest]) (*connect.Response[v1.GetTradeCalendarResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("datasource.v1.DataSourceService.GetTradeCalendar is not implemented"))
+}
+
+func (UnimplementedDataSourceServiceHandler) GetSourceHealth(context.Context, *connect.Request[v1.GetSourceHealthRequest]) (*connect.Response[v1.GetSourceHealthResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("datasource.v1.DataSourceService.GetSourceHealth is not implemented"))
+}
+
+func (UnimplementedDataSourceServiceHandler) WatchOptionChains(context.Context, *connect.Request[v1.WatchOptionChainsRequest], *connect.ServerStream[v1.WatchOptionChainsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("datasource.v1.DataSourceService.WatchOptionChains is not implemented"))
+}
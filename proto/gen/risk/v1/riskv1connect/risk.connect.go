@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: risk/v1/risk.proto
+
+package riskv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/ppaanngggg/option-bot/proto/gen/risk/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RiskServiceName is the fully-qualified name of the RiskService service.
+	RiskServiceName = "risk.v1.RiskService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RiskServiceGetExposureProcedure is the fully-qualified name of the RiskService's GetExposure RPC.
+	RiskServiceGetExposureProcedure = "/risk.v1.RiskService/GetExposure"
+	// RiskServiceListExposureSnapshotsProcedure is the fully-qualified name of the RiskService's
+	// ListExposureSnapshots RPC.
+	RiskServiceListExposureSnapshotsProcedure = "/risk.v1.RiskService/ListExposureSnapshots"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	riskServiceServiceDescriptor                     = v1.File_risk_v1_risk_proto.Services().ByName("RiskService")
+	riskServiceGetExposureMethodDescriptor           = riskServiceServiceDescriptor.Methods().ByName("GetExposure")
+	riskServiceListExposureSnapshotsMethodDescriptor = riskServiceServiceDescriptor.Methods().ByName("ListExposureSnapshots")
+)
+
+// RiskServiceClient is a client for the risk.v1.RiskService service.
+type RiskServiceClient interface {
+	GetExposure(context.Context, *connect.Request[v1.GetExposureRequest]) (*connect.Response[v1.GetExposureResponse], error)
+	ListExposureSnapshots(context.Context, *connect.Request[v1.ListExposureSnapshotsRequest]) (*connect.Response[v1.ListExposureSnapshotsResponse], error)
+}
+
+// NewRiskServiceClient constructs a client for the risk.v1.RiskService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRiskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RiskServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &riskServiceClient{
+		getExposure: connect.NewClient[v1.GetExposureRequest, v1.GetExposureResponse](
+			httpClient,
+			baseURL+RiskServiceGetExposureProcedure,
+			connect.WithSchema(riskServiceGetExposureMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listExposureSnapshots: connect.NewClient[v1.ListExposureSnapshotsRequest, v1.ListExposureSnapshotsResponse](
+			httpClient,
+			baseURL+RiskServiceListExposureSnapshotsProcedure,
+			connect.WithSchema(riskServiceListExposureSnapshotsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// riskServiceClient implements RiskServiceClient.
+type riskServiceClient struct {
+	getExposure           *connect.Client[v1.GetExposureRequest, v1.GetExposureResponse]
+	listExposureSnapshots *connect.Client[v1.ListExposureSnapshotsRequest, v1.ListExposureSnapshotsResponse]
+}
+
+// GetExposure calls risk.v1.RiskService.GetExposure.
+func (c *riskServiceClient) GetExposure(ctx context.Context, req *connect.Request[v1.GetExposureRequest]) (*connect.Response[v1.GetExposureResponse], error) {
+	return c.getExposure.CallUnary(ctx, req)
+}
+
+// ListExposureSnapshots calls risk.v1.RiskService.ListExposureSnapshots.
+func (c *riskServiceClient) ListExposureSnapshots(ctx context.Context, req *connect.Request[v1.ListExposureSnapshotsRequest]) (*connect.Response[v1.ListExposureSnapshotsResponse], error) {
+	return c.listExposureSnapshots.CallUnary(ctx, req)
+}
+
+// RiskServiceHandler is an implementation of the risk.v1.RiskService service.
+type RiskServiceHandler interface {
+	GetExposure(context.Context, *connect.Request[v1.GetExposureRequest]) (*connect.Response[v1.GetExposureResponse], error)
+	ListExposureSnapshots(context.Context, *connect.Request[v1.ListExposureSnapshotsRequest]) (*connect.Response[v1.ListExposureSnapshotsResponse], error)
+}
+
+// NewRiskServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRiskServiceHandler(svc RiskServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	riskServiceGetExposureHandler := connect.NewUnaryHandler(
+		RiskServiceGetExposureProcedure,
+		svc.GetExposure,
+		connect.WithSchema(riskServiceGetExposureMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	riskServiceListExposureSnapshotsHandler := connect.NewUnaryHandler(
+		RiskServiceListExposureSnapshotsProcedure,
+		svc.ListExposureSnapshots,
+		connect.WithSchema(riskServiceListExposureSnapshotsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/risk.v1.RiskService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RiskServiceGetExposureProcedure:
+			riskServiceGetExposureHandler.ServeHTTP(w, r)
+		case RiskServiceListExposureSnapshotsProcedure:
+			riskServiceListExposureSnapshotsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRiskServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRiskServiceHandler struct{}
+
+func (UnimplementedRiskServiceHandler) GetExposure(context.Context, *connect.Request[v1.GetExposureRequest]) (*connect.Response[v1.GetExposureResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("risk.v1.RiskService.GetExposure is not implemented"))
+}
+
+func (UnimplementedRiskServiceHandler) ListExposureSnapshots(context.Context, *connect.Request[v1.ListExposureSnapshotsRequest]) (*connect.Response[v1.ListExposureSnapshotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("risk.v1.RiskService.ListExposureSnapshots is not implemented"))
+}